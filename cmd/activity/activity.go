@@ -26,8 +26,9 @@ func init() {
 	Cmd.AddCommand(getCmd)
 
 	f := listCmd.Flags()
-	f.String("domain", "", "domain name or ID (required)")
+	f.String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	f.Int("limit", 0, "maximum number of results to return")
+	cmdutil.AddPageFlags(listCmd)
 	f.String("date-from", "", "start date as YYYY-MM-DD or unix timestamp (required)")
 	f.String("date-to", "", "end date as YYYY-MM-DD or unix timestamp (required)")
 	f.StringSlice("event", nil, "event types to filter (queued, sent, delivered, soft_bounced, hard_bounced, opened, clicked, unsubscribed, spam_complaints)")
@@ -44,7 +45,7 @@ var listCmd = &cobra.Command{
 
 func runList(cobraCmd *cobra.Command, args []string) error {
 	flags := cobraCmd.Flags()
-	domainIDStr, _ := flags.GetString("domain")
+	domainIDStr := cmdutil.DomainFlag(cobraCmd)
 	dateFromStr, _ := flags.GetString("date-from")
 	dateToStr, _ := flags.GetString("date-to")
 
@@ -74,7 +75,7 @@ func runList(cobraCmd *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
-	items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.ActivityData, bool, error) {
+	items, err := cmdutil.FetchList(ctx, cobraCmd, func(ctx context.Context, page, perPage int) ([]mailersend.ActivityData, bool, error) {
 		root, _, err := ms.Activity.List(ctx, &mailersend.ActivityOptions{
 			DomainID: domainID,
 			Page:     page,
@@ -105,11 +106,11 @@ func runList(cobraCmd *cobra.Command, args []string) error {
 			item.Type,
 			item.Email.From,
 			output.Truncate(item.Email.Subject, 40),
-			item.CreatedAt,
+			output.FormatTimeString(item.CreatedAt),
 		})
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
@@ -210,7 +211,7 @@ func runGet(cobraCmd *cobra.Command, args []string) error {
 	fmt.Printf("%-20s %s\n", "Subject:", d.Email.Subject)
 	fmt.Printf("%-20s %s\n", "Status:", d.Email.Status)
 	fmt.Printf("%-20s %s\n", "Recipient Email:", d.Email.Recipient.Email)
-	fmt.Printf("%-20s %s\n", "Created At:", d.CreatedAt)
+	fmt.Printf("%-20s %s\n", "Created At:", output.FormatTimeString(d.CreatedAt))
 
 	return nil
 }
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
+	appanalytics "github.com/mailersend/mailersend-cli/internal/analytics"
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
@@ -33,6 +33,7 @@ func init() {
 	df.String("group-by", "", "group by: days, weeks, months, years")
 	df.StringSlice("tags", nil, "filter by tags")
 	df.StringSlice("event", nil, "event types to retrieve (required, min 1): queued, sent, delivered, soft_bounced, hard_bounced, opened, clicked, unsubscribed, spam_complaints")
+	df.Bool("series", false, "output JSON time series keyed by event: {event: [{date, count}, ...]}")
 
 	// country flags
 	cf := countryCmd.Flags()
@@ -40,6 +41,7 @@ func init() {
 	cf.String("date-to", "", "end date as YYYY-MM-DD or unix timestamp (required)")
 	cf.String("domain", "", "filter by domain name or ID")
 	cf.StringSlice("tags", nil, "filter by tags")
+	cf.Bool("with-percent", false, "add a PERCENT column with each row's share of the total count")
 
 	// ua-name flags
 	uf := uaNameCmd.Flags()
@@ -47,6 +49,7 @@ func init() {
 	uf.String("date-to", "", "end date as YYYY-MM-DD or unix timestamp (required)")
 	uf.String("domain", "", "filter by domain name or ID")
 	uf.StringSlice("tags", nil, "filter by tags")
+	uf.Bool("with-percent", false, "add a PERCENT column with each row's share of the total count")
 
 	// ua-type flags
 	tf := uaTypeCmd.Flags()
@@ -54,6 +57,7 @@ func init() {
 	tf.String("date-to", "", "end date as YYYY-MM-DD or unix timestamp (required)")
 	tf.String("domain", "", "filter by domain name or ID")
 	tf.StringSlice("tags", nil, "filter by tags")
+	tf.Bool("with-percent", false, "add a PERCENT column with each row's share of the total count")
 }
 
 // --- analytics date ---
@@ -95,8 +99,7 @@ func runDate(cobraCmd *cobra.Command, args []string) error {
 	groupBy, _ := flags.GetString("group-by")
 	tags, _ := flags.GetStringSlice("tags")
 
-	ctx := context.Background()
-	result, _, err := ms.Analytics.GetActivityByDate(ctx, &mailersend.AnalyticsOptions{
+	return appanalytics.RenderDate(cobraCmd, ms, &mailersend.AnalyticsOptions{
 		DomainID: domainID,
 		DateFrom: dateFrom,
 		DateTo:   dateTo,
@@ -104,58 +107,6 @@ func runDate(cobraCmd *cobra.Command, args []string) error {
 		Tags:     tags,
 		Event:    events,
 	})
-	if err != nil {
-		return sdkclient.WrapError(err)
-	}
-
-	if cmdutil.JSONFlag(cobraCmd) {
-		return output.JSON(result)
-	}
-
-	headers := []string{"DATE"}
-	for _, e := range events {
-		headers = append(headers, strings.ToUpper(e))
-	}
-
-	var rows [][]string
-	for _, stat := range result.Data.Stats {
-		row := []string{stat.Date}
-		for _, e := range events {
-			row = append(row, fmt.Sprintf("%d", statValue(stat, e)))
-		}
-		rows = append(rows, row)
-	}
-
-	output.Table(headers, rows)
-	return nil
-}
-
-// statValue extracts a named stat field from AnalyticsStats by event name.
-func statValue(s mailersend.AnalyticsStats, event string) int {
-	switch event {
-	case "queued":
-		return s.Queued
-	case "sent":
-		return s.Sent
-	case "delivered":
-		return s.Delivered
-	case "soft_bounced":
-		return s.SoftBounced
-	case "hard_bounced":
-		return s.HardBounced
-	case "junk":
-		return s.Junk
-	case "opened":
-		return s.Opened
-	case "clicked":
-		return s.Clicked
-	case "unsubscribed":
-		return s.Unsubscribed
-	case "spam_complaints":
-		return s.SpamComplaints
-	default:
-		return 0
-	}
 }
 
 // --- analytics country ---
@@ -178,7 +129,7 @@ func runCountry(cobraCmd *cobra.Command, args []string) error {
 		return sdkclient.WrapError(err)
 	}
 
-	return renderOpens(cobraCmd, result, "COUNTRY", "COUNT")
+	return renderOpens(cobraCmd, result, opts.options, "COUNTRY", "COUNT")
 }
 
 // --- analytics ua-name ---
@@ -201,7 +152,7 @@ func runUAName(cobraCmd *cobra.Command, args []string) error {
 		return sdkclient.WrapError(err)
 	}
 
-	return renderOpens(cobraCmd, result, "USER AGENT", "COUNT")
+	return renderOpens(cobraCmd, result, opts.options, "USER AGENT", "COUNT")
 }
 
 // --- analytics ua-type ---
@@ -224,7 +175,7 @@ func runUAType(cobraCmd *cobra.Command, args []string) error {
 		return sdkclient.WrapError(err)
 	}
 
-	return renderOpens(cobraCmd, result, "TYPE", "COUNT")
+	return renderOpens(cobraCmd, result, opts.options, "TYPE", "COUNT")
 }
 
 // --- shared helpers for country / ua-name / ua-type ---
@@ -272,17 +223,45 @@ func buildOpensOptions(cobraCmd *cobra.Command) (*opensContext, error) {
 	}, nil
 }
 
-func renderOpens(cobraCmd *cobra.Command, result *mailersend.OpensRoot, nameHeader, countHeader string) error {
+func renderOpens(cobraCmd *cobra.Command, result *mailersend.OpensRoot, opts *mailersend.AnalyticsOptions, nameHeader, countHeader string) error {
 	if cmdutil.JSONFlag(cobraCmd) {
 		return output.JSON(result)
 	}
 
+	if len(result.Data.Stats) == 0 {
+		appanalytics.NoteEmptyRange(opts)
+	}
+
 	headers := []string{nameHeader, countHeader}
-	var rows [][]string
-	for _, stat := range result.Data.Stats {
-		rows = append(rows, []string{stat.Name, strconv.Itoa(stat.Count)})
+	withPercent, _ := cobraCmd.Flags().GetBool("with-percent")
+	if withPercent {
+		headers = append(headers, "PERCENT")
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, opensRows(result.Data.Stats, withPercent))
 	return nil
 }
+
+// opensRows builds the NAME/COUNT rows for opens stats. With withPercent
+// each row also gets its share of the total count to one decimal; a zero
+// total gives 0.0% rather than dividing by zero.
+func opensRows(stats []mailersend.OpenStats, withPercent bool) [][]string {
+	total := 0
+	for _, stat := range stats {
+		total += stat.Count
+	}
+
+	var rows [][]string
+	for _, stat := range stats {
+		row := []string{stat.Name, strconv.Itoa(stat.Count)}
+		if withPercent {
+			share := 0.0
+			if total > 0 {
+				share = float64(stat.Count) * 100 / float64(total)
+			}
+			row = append(row, strconv.FormatFloat(share, 'f', 1, 64)+"%")
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
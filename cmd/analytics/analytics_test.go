@@ -0,0 +1,156 @@
+package analytics
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mailersend/mailersend-go"
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func TestDateCmd_SeriesMockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"date_from": "1704067200",
+				"date_to":   "1704153600",
+				"group_by":  "days",
+				"stats": []map[string]interface{}{
+					{"date": "2024-01-01", "sent": 10},
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"analytics", "date", "--event", "sent", "--series"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+}
+
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	origStderr := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stderr = w
+	defer func() { os.Stderr = origStderr }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestDateCmd_EmptyRangeNote(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"date_from": "1893456000",
+				"date_to":   "1894060800",
+				"group_by":  "days",
+				"stats":     []interface{}{},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	_ = dateCmd.Flags().Set("series", "false")
+
+	root := newRootCmd()
+	defer func() { _ = root.PersistentFlags().Set("json", "false") }()
+
+	for _, tt := range []struct {
+		name     string
+		json     bool
+		wantNote bool
+	}{
+		{"table", false, true},
+		{"json", true, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// Set --json directly: cobra caches inherited persistent flags
+			// from the first root a command was attached to.
+			_ = root.PersistentFlags().Set("json", strconv.FormatBool(tt.json))
+			root.SetArgs([]string{"analytics", "date", "--event", "sent", "--date-from", "2030-01-01", "--date-to", "2030-01-08"})
+
+			stderr := captureStderr(t, func() {
+				if err := root.Execute(); err != nil {
+					t.Fatalf("command returned error: %v", err)
+				}
+			})
+
+			from := time.Unix(1893456000, 0).Format("2006-01-02")
+			hasNote := strings.Contains(stderr, "No analytics data between "+from)
+			if hasNote != tt.wantNote {
+				t.Errorf("note present = %v, want %v; stderr = %q", hasNote, tt.wantNote, stderr)
+			}
+		})
+	}
+}
+
+func TestOpensRows_WithPercent(t *testing.T) {
+	stats := []mailersend.OpenStats{
+		{Name: "US", Count: 5},
+		{Name: "DE", Count: 3},
+		{Name: "FR", Count: 1},
+	}
+
+	rows := opensRows(stats, true)
+	if len(rows) != 3 || rows[0][2] != "55.6%" || rows[2][2] != "11.1%" {
+		t.Fatalf("unexpected rows: %v", rows)
+	}
+	sum := 0.0
+	for _, row := range rows {
+		v, err := strconv.ParseFloat(strings.TrimSuffix(row[2], "%"), 64)
+		if err != nil {
+			t.Fatalf("invalid percent %q: %v", row[2], err)
+		}
+		sum += v
+	}
+	if sum < 99.8 || sum > 100.2 {
+		t.Errorf("percentages sum to %.1f, want ~100", sum)
+	}
+
+	rows = opensRows([]mailersend.OpenStats{{Name: "US", Count: 0}}, true)
+	if rows[0][2] != "0.0%" {
+		t.Errorf("zero total percent = %q, want 0.0%%", rows[0][2])
+	}
+	if rows = opensRows(stats, false); len(rows[0]) != 2 {
+		t.Errorf("expected no PERCENT column without the flag, got %v", rows[0])
+	}
+}
@@ -8,17 +8,21 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"os/exec"
 	"runtime"
 	"strings"
 	"time"
 
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/config"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/mailersend/mailersend-cli/internal/scopes"
 	"github.com/spf13/cobra"
 )
 
@@ -26,14 +30,13 @@ const (
 	oauthClientID     = "1007"
 	oauthAuthorizeURL = "https://app.mailersend.com/oauth/authorize"
 	oauthTokenURL     = "https://app.mailersend.com/oauth/token"
-
-	// All "full" scopes matching ParseScopesFromMatrix(false, []).
-	oauthScopes = "email_full tokens_full webhooks_full templates_full inbounds_full " +
-		"domains_full activity_full analytics_full suppressions_full sms_full " +
-		"email_verification_full recipients_full sender_identity_full " +
-		"smtp_users_full users_full dmarc_monitoring_full"
+	oauthDeviceURL    = "https://app.mailersend.com/oauth/device/code"
 )
 
+// oauthScopes requests every "full" scope, matching
+// ParseScopesFromMatrix(false, []).
+var oauthScopes = strings.Join(scopes.Full(), " ")
+
 var Cmd = &cobra.Command{
 	Use:   "auth",
 	Short: "Authenticate with MailerSend",
@@ -42,8 +45,12 @@ var Cmd = &cobra.Command{
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Log in to MailerSend",
-	Long:  "Authenticate via API token or OAuth browser flow.",
-	RunE:  runLogin,
+	Long: `Authenticate via API token or OAuth browser flow.
+
+On a machine without a browser, such as over SSH, use --device: it prints a
+URL and a code to enter on any other device, and waits until you approve the
+login there.`,
+	RunE: runLogin,
 }
 
 var logoutCmd = &cobra.Command{
@@ -59,9 +66,15 @@ var statusCmd = &cobra.Command{
 }
 
 func init() {
+	config.SetTokenRefresher(RefreshToken)
+
 	loginCmd.Flags().String("method", "", "auth method: token or oauth")
-	loginCmd.Flags().String("token", "", "API token (for token method)")
-	loginCmd.Flags().String("profile", "", "profile name to save credentials to (default: uses active profile or 'default')")
+	loginCmd.Flags().Bool("device", false, "log in with OAuth by entering a code on another device, for machines without a browser")
+	loginCmd.Flags().String("token", "", "API token (for token method), or - to read it from stdin")
+	loginCmd.Flags().String("profile", "", "profile name to save credentials to (default: $MAILERSEND_PROFILE or 'default')")
+	loginCmd.Flags().Bool("force", false, "overwrite an existing profile without asking")
+	loginCmd.Flags().BoolP("yes", "y", false, "same as --force")
+	statusCmd.Flags().Bool("show-token", false, "print the token in full instead of masked")
 	Cmd.AddCommand(loginCmd, logoutCmd, statusCmd)
 }
 
@@ -69,18 +82,21 @@ func runLogin(cmd *cobra.Command, args []string) error {
 	method, _ := cmd.Flags().GetString("method")
 	token, _ := cmd.Flags().GetString("token")
 	profName, _ := cmd.Flags().GetString("profile")
-
-	if method == "" && prompt.IsInteractive() {
-		var err error
-		method, err = prompt.SelectLabeled("Authentication method", []string{"OAuth (Recommended)", "API Token (less secure)"}, []string{"oauth", "token"})
-		if err != nil {
-			return err
+	force, _ := cmd.Flags().GetBool("force")
+	device, _ := cmd.Flags().GetBool("device")
+	if device {
+		if method != "" && method != "oauth" {
+			return fmt.Errorf("--device cannot be combined with --method %s", method)
 		}
-	}
-	if method == "" {
 		method = "oauth"
 	}
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		force = true
+	}
 
+	if profName == "" {
+		profName = os.Getenv(config.ProfileEnvVar)
+	}
 	if profName == "" {
 		profName = "default"
 	}
@@ -90,9 +106,33 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Only stored credentials need confirming; a profile holding just a
+	// default domain is filled in without asking.
+	if existing := cfg.Profiles[profName]; (existing.APIToken != "" || existing.OAuthToken != "") && !force {
+		if err := confirmOverwrite(profName, existing); err != nil {
+			return err
+		}
+	}
+
+	if method == "" && prompt.IsInteractive() {
+		method, err = prompt.SelectLabeled("Authentication method", []string{"OAuth (Recommended)", "API Token (less secure)"}, []string{"oauth", "token"})
+		if err != nil {
+			return err
+		}
+	}
+	if method == "" {
+		method = "oauth"
+	}
+
 	switch method {
 	case "token":
-		if token == "" {
+		if token == "-" {
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return fmt.Errorf("failed to read token from stdin: %w", err)
+			}
+			token = strings.TrimSpace(string(data))
+		} else if token == "" {
 			if !prompt.IsInteractive() {
 				return fmt.Errorf("--token is required in non-interactive mode")
 			}
@@ -104,13 +144,25 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		if token == "" {
 			return fmt.Errorf("token cannot be empty")
 		}
-		cfg.Profiles[profName] = config.Profile{APIToken: token}
+		prof := cfg.Profiles[profName]
+		prof.APIToken = token
+		prof.OAuthToken, prof.OAuthRefreshToken, prof.OAuthExpiresAt = "", "", ""
+		cfg.Profiles[profName] = prof
 
 	case "oauth":
-		prof, err := oauthBrowserFlow()
+		flow := oauthBrowserFlow
+		if device {
+			flow = oauthDeviceFlow
+		}
+		creds, err := flow()
 		if err != nil {
 			return fmt.Errorf("OAuth login failed: %w", err)
 		}
+		prof := cfg.Profiles[profName]
+		prof.APIToken = ""
+		prof.OAuthToken = creds.OAuthToken
+		prof.OAuthRefreshToken = creds.OAuthRefreshToken
+		prof.OAuthExpiresAt = creds.OAuthExpiresAt
 		cfg.Profiles[profName] = prof
 
 	default:
@@ -122,12 +174,49 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmdutil.JSONFlag(cmd) {
+		prof := cfg.Profiles[profName]
+		return output.JSON(loginResult{
+			Profile:   profName,
+			Method:    prof.Method(),
+			ExpiresAt: prof.OAuthExpiresAt,
+		})
+	}
+
 	output.Success(fmt.Sprintf("Logged in successfully. Profile: %s", profName))
 	return nil
 }
 
+// loginResult is the --json output of auth login. ExpiresAt is set only for
+// OAuth logins.
+type loginResult struct {
+	Profile   string `json:"profile"`
+	Method    string `json:"method"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// confirmOverwrite asks before replacing the credentials stored in an
+// existing profile. Without a terminal to ask on, --force is required.
+func confirmOverwrite(name string, existing config.Profile) error {
+	current := "an API token"
+	if existing.OAuthToken != "" {
+		current = "OAuth credentials"
+	}
+	if !prompt.IsInteractive() {
+		return fmt.Errorf("profile %q already has %s; use --force to overwrite it", name, current)
+	}
+	ok, err := prompt.Confirm(fmt.Sprintf("Profile %q already has %s. Overwrite?", name, current))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("login cancelled; profile %q was not changed", name)
+	}
+	return nil
+}
+
 func runLogout(cmd *cobra.Command, args []string) error {
-	profFlag, _ := cmd.Root().PersistentFlags().GetString("profile")
+	profFlag := cmdutil.ProfileFlag(cmd)
 
 	cfg, err := config.Load()
 	if err != nil {
@@ -142,17 +231,8 @@ func runLogout(cmd *cobra.Command, args []string) error {
 		name = "default"
 	}
 
-	if _, ok := cfg.Profiles[name]; !ok {
-		return fmt.Errorf("profile %q not found", name)
-	}
-
-	delete(cfg.Profiles, name)
-	if cfg.ActiveProfile == name {
-		cfg.ActiveProfile = ""
-		for n := range cfg.Profiles {
-			cfg.ActiveProfile = n
-			break
-		}
+	if err := cfg.RemoveProfile(name); err != nil {
+		return err
 	}
 
 	if err := config.Save(cfg); err != nil {
@@ -164,7 +244,7 @@ func runLogout(cmd *cobra.Command, args []string) error {
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
-	profFlag, _ := cmd.Root().PersistentFlags().GetString("profile")
+	profFlag := cmdutil.ProfileFlag(cmd)
 
 	cfg, err := config.Load()
 	if err != nil {
@@ -186,10 +266,16 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	jsonFlag, _ := cmd.Root().PersistentFlags().GetBool("json")
-	if jsonFlag {
+	token := config.MaskToken(prof.Token())
+	if showToken, _ := cmd.Flags().GetBool("show-token"); showToken {
+		token = prof.Token()
+	}
+
+	if cmdutil.JSONFlag(cmd) {
 		return output.JSON(map[string]interface{}{
 			"profile":    name,
+			"method":     prof.Method(),
+			"token":      token,
 			"has_token":  prof.APIToken != "",
 			"has_oauth":  prof.OAuthToken != "",
 			"expires_at": prof.OAuthExpiresAt,
@@ -197,25 +283,19 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	method := "API Token"
-	if prof.OAuthToken != "" {
+	if prof.Method() == "oauth" {
 		method = "OAuth"
 	}
-	maskedToken := "none"
-	if prof.APIToken != "" {
-		t := prof.APIToken
-		if len(t) > 10 {
-			maskedToken = t[:7] + "..." + t[len(t)-4:]
-		} else {
-			maskedToken = "***"
-		}
+	if token == "" {
+		token = "none"
 	}
 
-	output.Table(
+	output.Render(
 		[]string{"Field", "Value"},
 		[][]string{
 			{"Profile", name},
 			{"Method", method},
-			{"Token", maskedToken},
+			{"Token", token},
 			{"Active", "Yes"},
 		},
 	)
@@ -311,24 +391,80 @@ type tokenResponse struct {
 // exchangeCodeForTokens POSTs to the Passport token endpoint with the
 // authorization code and PKCE verifier to obtain access and refresh tokens.
 func exchangeCodeForTokens(code, redirectURI, codeVerifier string) (config.Profile, error) {
-	data := url.Values{
+	return requestTokens(url.Values{
 		"grant_type":    {"authorization_code"},
 		"client_id":     {oauthClientID},
 		"redirect_uri":  {redirectURI},
 		"code":          {code},
 		"code_verifier": {codeVerifier},
+	})
+}
+
+// RefreshToken exchanges prof's refresh token for a new access token and
+// returns the updated profile. The refresh token is kept if the server does
+// not issue a new one. GetToken calls it when an OAuth token is about to
+// expire.
+func RefreshToken(prof config.Profile) (config.Profile, error) {
+	if prof.OAuthRefreshToken == "" {
+		return config.Profile{}, fmt.Errorf("profile has no refresh token")
+	}
+	refreshed, err := requestTokens(url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {oauthClientID},
+		"refresh_token": {prof.OAuthRefreshToken},
+	})
+	if err != nil {
+		return config.Profile{}, err
+	}
+	if refreshed.OAuthRefreshToken == "" {
+		refreshed.OAuthRefreshToken = prof.OAuthRefreshToken
 	}
+	return refreshed, nil
+}
+
+// tokenEndpoint is the OAuth token URL; tests point it at a local server.
+var tokenEndpoint = oauthTokenURL
+
+// tokenError is a non-200 response from the token endpoint.
+type tokenError struct {
+	Status int
+	Body   map[string]interface{}
+}
+
+func (e *tokenError) Error() string {
+	return fmt.Sprintf("token request failed (HTTP %d): %v", e.Status, e.Body)
+}
+
+// Code returns the OAuth error code of the response, such as
+// "authorization_pending", or "" when there is none.
+func (e *tokenError) Code() string {
+	code, _ := e.Body["error"].(string)
+	return code
+}
+
+// oauthClient sends the requests to the OAuth endpoints. The root command
+// replaces it with one that honors --proxy and --timeout.
+var oauthClient = http.DefaultClient
+
+// SetHTTPClient sets the client used for OAuth requests, including token
+// refreshes made on behalf of other commands.
+func SetHTTPClient(client *http.Client) {
+	oauthClient = client
+}
 
-	resp, err := http.Post(oauthTokenURL, "application/x-www-form-urlencoded", strings.NewReader(data.Encode())) //nolint:gosec,noctx
+// requestTokens POSTs data to the token endpoint and converts the response
+// into an OAuth profile.
+func requestTokens(data url.Values) (config.Profile, error) {
+	resp, err := oauthClient.PostForm(tokenEndpoint, data) //nolint:noctx
 	if err != nil {
-		return config.Profile{}, fmt.Errorf("token exchange request failed: %w", err)
+		return config.Profile{}, fmt.Errorf("token request failed: %w", err)
 	}
 	defer resp.Body.Close() //nolint:errcheck
 
 	if resp.StatusCode != http.StatusOK {
 		var body map[string]interface{}
 		_ = json.NewDecoder(resp.Body).Decode(&body)
-		return config.Profile{}, fmt.Errorf("token exchange failed (HTTP %d): %v", resp.StatusCode, body)
+		return config.Profile{}, &tokenError{Status: resp.StatusCode, Body: body}
 	}
 
 	var tok tokenResponse
@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func TestLoginCmd_OverwriteRequiresForce(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() { _ = loginCmd.Flags().Set("force", "false") }()
+
+	cfg := &config.Config{
+		ActiveProfile: "work",
+		Profiles:      map[string]config.Profile{"work": {APIToken: "old-token", DefaultDomain: "dom-1"}},
+	}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	// With stdin on a pipe there is no terminal to confirm the overwrite on.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close() //nolint:errcheck
+	w.Close()       //nolint:errcheck
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"auth", "login", "--method", "token", "--token", "new-token", "--profile", "work"})
+	err = root.Execute()
+	if err == nil {
+		t.Fatal("expected overwrite to be blocked without --force")
+	}
+	if !strings.Contains(err.Error(), "--force") || !strings.Contains(err.Error(), "API token") {
+		t.Errorf("expected error naming the current method and --force, got %v", err)
+	}
+	if got := loadToken(t, "work"); got != "old-token" {
+		t.Errorf("expected profile unchanged, got token %q", got)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"auth", "login", "--method", "token", "--token", "new-token", "--profile", "work", "--force"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("expected --force to overwrite, got %v", err)
+	}
+	if got := loadToken(t, "work"); got != "new-token" {
+		t.Errorf("expected token overwritten, got %q", got)
+	}
+	if cfg, _ := config.Load(); cfg.Profiles["work"].DefaultDomain != "dom-1" {
+		t.Errorf("expected default_domain kept, got %q", cfg.Profiles["work"].DefaultDomain)
+	}
+}
+
+func loadToken(t *testing.T, profile string) string {
+	t.Helper()
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg.Profiles[profile].APIToken
+}
+
+func TestLoginCmd_ProfileWithoutCredentialsNeedsNoForce(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := &config.Config{
+		ActiveProfile: "work",
+		Profiles:      map[string]config.Profile{"work": {DefaultDomain: "dom-1"}},
+	}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close() //nolint:errcheck
+	w.Close()       //nolint:errcheck
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"auth", "login", "--method", "token", "--token", "new-token", "--profile", "work"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("expected login without --force, got %v", err)
+	}
+	if got := loadToken(t, "work"); got != "new-token" {
+		t.Errorf("expected token stored, got %q", got)
+	}
+}
+
+func TestRefreshToken_KeepsRefreshTokenWhenNotRotated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("refresh_token") != "refresh-1" {
+			t.Errorf("unexpected form: %v", r.PostForm)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access-2","expires_in":3600}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	orig := tokenEndpoint
+	tokenEndpoint = server.URL
+	defer func() { tokenEndpoint = orig }()
+
+	got, err := RefreshToken(config.Profile{OAuthToken: "access-1", OAuthRefreshToken: "refresh-1"})
+	if err != nil {
+		t.Fatalf("RefreshToken() error: %v", err)
+	}
+	if got.OAuthToken != "access-2" {
+		t.Errorf("OAuthToken = %q, want access-2", got.OAuthToken)
+	}
+	if got.OAuthRefreshToken != "refresh-1" {
+		t.Errorf("OAuthRefreshToken = %q, want the original refresh-1", got.OAuthRefreshToken)
+	}
+	if got.OAuthExpiresAt == "" {
+		t.Error("OAuthExpiresAt is empty")
+	}
+}
+
+func TestRequestTokens_UsesConfiguredClient(t *testing.T) {
+	// The test server stands in for a --proxy: requests for the unresolvable
+	// token endpoint only succeed if they go through it.
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"access-2","expires_in":3600}`)) //nolint:errcheck
+	}))
+	defer proxy.Close()
+
+	base, err := sdkclient.ProxyTransport(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetHTTPClient(&http.Client{Transport: base})
+	defer SetHTTPClient(http.DefaultClient)
+	orig := tokenEndpoint
+	tokenEndpoint = "http://oauth.invalid/token"
+	defer func() { tokenEndpoint = orig }()
+
+	if _, err := requestTokens(url.Values{"grant_type": {"refresh_token"}}); err != nil {
+		t.Fatalf("requestTokens() error: %v", err)
+	}
+	if proxied != "http://oauth.invalid/token" {
+		t.Errorf("proxy saw %q, want the token endpoint", proxied)
+	}
+}
+
+func TestLoginCmd_JSONResultForTokenLogin(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"auth", "login", "--method", "token", "--token", "mlsn_test", "--profile", "ci"})
+
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	err = root.Execute()
+	os.Stdout = origStdout
+	w.Close() //nolint:errcheck
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	out, _ := io.ReadAll(r)
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	want := map[string]interface{}{"profile": "ci", "method": "token"}
+	if len(got) != len(want) || got["profile"] != want["profile"] || got["method"] != want["method"] {
+		t.Errorf("login JSON = %v, want %v", got, want)
+	}
+}
+
+func TestLoginCmd_TokenFromStdin(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdinW.Write([]byte("mlsn_from_stdin\n")) //nolint:errcheck
+	stdinW.Close()                            //nolint:errcheck
+	origStdin := os.Stdin
+	os.Stdin = stdinR
+	defer func() { os.Stdin = origStdin }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"auth", "login", "--method", "token", "--token", "-", "--profile", "stdin"})
+	defer loginCmd.Flags().Set("token", "") //nolint:errcheck
+	if err := root.Execute(); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Profiles["stdin"].APIToken; got != "mlsn_from_stdin" {
+		t.Errorf("saved token = %q, want mlsn_from_stdin", got)
+	}
+}
+
+func TestStatusCmd_MasksTokenUnlessShown(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	const oauthToken = "oauth-access-token-1234"
+	if err := config.Save(&config.Config{
+		ActiveProfile: "work",
+		Profiles:      map[string]config.Profile{"work": {OAuthToken: oauthToken, OAuthExpiresAt: "2026-11-01T00:00:00Z"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer statusCmd.Flags().Set("show-token", "false") //nolint:errcheck
+
+	run := func(args ...string) map[string]interface{} {
+		t.Helper()
+		root := newRootCmd()
+		if err := root.PersistentFlags().Set("json", "true"); err != nil {
+			t.Fatal(err)
+		}
+		root.SetArgs(append([]string{"auth", "status"}, args...))
+
+		origStdout := os.Stdout
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdout = w
+		err = root.Execute()
+		os.Stdout = origStdout
+		w.Close() //nolint:errcheck
+		if err != nil {
+			t.Fatalf("status failed: %v", err)
+		}
+		out, _ := io.ReadAll(r)
+		var got map[string]interface{}
+		if err := json.Unmarshal(out, &got); err != nil {
+			t.Fatalf("output is not JSON: %v\n%s", err, out)
+		}
+		return got
+	}
+
+	if got := run(); got["token"] != config.MaskToken(oauthToken) || got["method"] != "oauth" {
+		t.Errorf("status JSON = %v, want the OAuth token masked", got)
+	}
+	if got := run("--show-token"); got["token"] != oauthToken {
+		t.Errorf("status --show-token token = %v, want %q", got["token"], oauthToken)
+	}
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/config"
+)
+
+// deviceGrantType is the grant type of the OAuth device authorization grant
+// (RFC 8628).
+const deviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// deviceEndpoint is the OAuth device authorization URL; tests point it at a
+// local server.
+var deviceEndpoint = oauthDeviceURL
+
+// deviceSleep waits between token requests; tests replace it.
+var deviceSleep = time.Sleep
+
+// errDeviceUnsupported is returned when the server does not offer the
+// device flow to this client.
+var errDeviceUnsupported = errors.New("MailerSend does not support device login for this client; run `auth login` on a machine with a browser, or use --method token")
+
+// deviceCodeResponse is the JSON response from the device authorization
+// endpoint.
+type deviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// oauthDeviceFlow performs the OAuth 2.0 device authorization grant. It
+// prints a verification URL and user code, then polls the token endpoint
+// until the user approves the login, denies it, or the code expires.
+func oauthDeviceFlow() (config.Profile, error) {
+	dc, err := requestDeviceCode()
+	if err != nil {
+		return config.Profile{}, err
+	}
+
+	fmt.Fprintf(os.Stderr, "To log in, visit:\n%s\n\nand enter the code: %s\n\n", dc.VerificationURI, dc.UserCode)
+	if dc.VerificationURIComplete != "" {
+		fmt.Fprintf(os.Stderr, "Or open this URL, which fills in the code:\n%s\n\n", dc.VerificationURIComplete)
+	}
+	fmt.Fprintln(os.Stderr, "Waiting for approval...")
+
+	interval := time.Duration(max(dc.Interval, 5)) * time.Second
+	expiresIn := time.Duration(dc.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = 15 * time.Minute
+	}
+	deadline := time.Now().Add(expiresIn)
+
+	for {
+		deviceSleep(interval)
+		prof, err := requestTokens(url.Values{
+			"grant_type":  {deviceGrantType},
+			"client_id":   {oauthClientID},
+			"device_code": {dc.DeviceCode},
+		})
+		if err == nil {
+			return prof, nil
+		}
+
+		var te *tokenError
+		if !errors.As(err, &te) {
+			return config.Profile{}, err
+		}
+		switch te.Code() {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return config.Profile{}, fmt.Errorf("the login was denied")
+		case "expired_token":
+			return config.Profile{}, fmt.Errorf("the code expired before the login was approved; run `auth login --device` again")
+		case "unsupported_grant_type", "unauthorized_client":
+			return config.Profile{}, errDeviceUnsupported
+		default:
+			return config.Profile{}, err
+		}
+		if time.Now().After(deadline) {
+			return config.Profile{}, fmt.Errorf("the code expired before the login was approved; run `auth login --device` again")
+		}
+	}
+}
+
+// requestDeviceCode asks the device authorization endpoint for a device
+// code and the user code to show.
+func requestDeviceCode() (deviceCodeResponse, error) {
+	resp, err := oauthClient.PostForm(deviceEndpoint, url.Values{ //nolint:noctx
+		"client_id": {oauthClientID},
+		"scope":     {oauthScopes},
+	})
+	if err != nil {
+		return deviceCodeResponse{}, fmt.Errorf("device authorization request failed: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		var body struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		switch {
+		case resp.StatusCode == http.StatusNotFound, resp.StatusCode == http.StatusMethodNotAllowed,
+			body.Error == "unsupported_grant_type", body.Error == "unauthorized_client":
+			return deviceCodeResponse{}, errDeviceUnsupported
+		}
+		return deviceCodeResponse{}, fmt.Errorf("device authorization request failed (HTTP %d): %s", resp.StatusCode, body.Error)
+	}
+
+	var dc deviceCodeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dc); err != nil {
+		return deviceCodeResponse{}, fmt.Errorf("failed to parse device authorization response: %w", err)
+	}
+	if dc.DeviceCode == "" || dc.UserCode == "" || dc.VerificationURI == "" {
+		return deviceCodeResponse{}, errDeviceUnsupported
+	}
+	return dc, nil
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/config"
+)
+
+func TestLoginCmd_DeviceFlow(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/device/code":
+			w.Write([]byte(`{"device_code":"dev-1","user_code":"ABCD-EFGH","verification_uri":"https://example.com/device","expires_in":600,"interval":1}`)) //nolint:errcheck
+		case "/token":
+			if r.PostForm.Get("grant_type") != deviceGrantType || r.PostForm.Get("device_code") != "dev-1" {
+				t.Errorf("unexpected token form: %v", r.PostForm)
+			}
+			polls++
+			switch polls {
+			case 1:
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":"authorization_pending"}`)) //nolint:errcheck
+			case 2:
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":"slow_down"}`)) //nolint:errcheck
+			default:
+				w.Write([]byte(`{"access_token":"access-1","refresh_token":"refresh-1","expires_in":3600}`)) //nolint:errcheck
+			}
+		}
+	}))
+	defer server.Close()
+
+	origDevice, origToken, origSleep := deviceEndpoint, tokenEndpoint, deviceSleep
+	defer func() { deviceEndpoint, tokenEndpoint, deviceSleep = origDevice, origToken, origSleep }()
+	deviceEndpoint, tokenEndpoint = server.URL+"/device/code", server.URL+"/token"
+	var waits []time.Duration
+	deviceSleep = func(d time.Duration) { waits = append(waits, d) }
+
+	// Flags keep their values across tests.
+	if err := loginCmd.Flags().Set("method", ""); err != nil {
+		t.Fatal(err)
+	}
+	root := newRootCmd()
+	root.SetArgs([]string{"auth", "login", "--device", "--profile", "headless"})
+	defer loginCmd.Flags().Set("device", "false") //nolint:errcheck
+	if err := root.Execute(); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	if polls != 3 {
+		t.Errorf("polled %d times, want 3", polls)
+	}
+	// The interval is at least 5s and grows by 5s on slow_down.
+	if len(waits) != 3 || waits[0] != 5*time.Second || waits[2] != 10*time.Second {
+		t.Errorf("waits = %v, want [5s 5s 10s]", waits)
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Profiles["headless"]; got.OAuthToken != "access-1" || got.OAuthRefreshToken != "refresh-1" {
+		t.Errorf("saved profile = %+v", got)
+	}
+}
+
+func TestOAuthDeviceFlow_Unsupported(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	orig := deviceEndpoint
+	deviceEndpoint = server.URL
+	defer func() { deviceEndpoint = orig }()
+
+	if _, err := oauthDeviceFlow(); !errors.Is(err, errDeviceUnsupported) {
+		t.Errorf("err = %v, want errDeviceUnsupported", err)
+	}
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-go"
+	"github.com/spf13/cobra"
+)
+
+// accessProbe is a read-only request that succeeds only when the token has
+// scope.
+type accessProbe struct {
+	scope string
+	check func(ctx context.Context, ms *mailersend.Mailersend) error
+}
+
+// accessProbes are the list endpoints whoami tries. Endpoints that need a
+// domain ID, such as webhooks, are left out.
+var accessProbes = []accessProbe{
+	{"domains_read", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.Domain.List(ctx, &mailersend.ListDomainOptions{Limit: 10})
+		return err
+	}},
+	{"templates_full", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.Template.List(ctx, &mailersend.ListTemplateOptions{Limit: 10})
+		return err
+	}},
+	{"suppressions_read", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.Suppression.ListBlockList(ctx, &mailersend.SuppressionOptions{Limit: 10})
+		return err
+	}},
+	{"recipients_read", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.Recipient.List(ctx, &mailersend.ListRecipientOptions{Limit: 10})
+		return err
+	}},
+	{"sender_identity_read", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.Identity.List(ctx, &mailersend.ListIdentityOptions{Limit: 10})
+		return err
+	}},
+	{"email_verification_read", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.EmailVerification.List(ctx, &mailersend.ListEmailVerificationOptions{Limit: 10})
+		return err
+	}},
+	{"inbounds_full", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.Inbound.List(ctx, &mailersend.ListInboundOptions{Limit: 10})
+		return err
+	}},
+	{"sms_read", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.SmsNumber.List(ctx, &mailersend.SmsNumberOptions{Limit: 10})
+		return err
+	}},
+	{"users_full", func(ctx context.Context, ms *mailersend.Mailersend) error {
+		_, _, err := ms.User.List(ctx, &mailersend.ListUserOptions{Limit: 10})
+		return err
+	}},
+}
+
+// whoamiResult is the --json output of auth whoami.
+type whoamiResult struct {
+	Source    string   `json:"source"`
+	Token     string   `json:"token"`
+	Valid     bool     `json:"valid"`
+	Quota     int      `json:"quota"`
+	Remaining int      `json:"remaining"`
+	Access    []string `json:"access"`
+}
+
+var whoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Check that the current token works",
+	Long: `Call the API with the token the CLI would use and report whether it is
+accepted, along with the API quota and the read access it has.
+
+MailerSend does not expose the account or scopes behind a token, so access
+is found by trying a read-only list request per resource. Write access and
+resources that need a domain, such as webhooks, are not checked. The command
+exits non-zero when the token is invalid or expired.`,
+	Args: cobra.NoArgs,
+	RunE: runWhoami,
+}
+
+func init() {
+	Cmd.AddCommand(whoamiCmd)
+}
+
+func runWhoami(cmd *cobra.Command, args []string) error {
+	ms, err := cmdutil.NewSDKClient(cmd)
+	if err != nil {
+		return err
+	}
+	source := config.TokenSource(cmdutil.ProfileFlag(cmd))
+
+	ctx := context.Background()
+	quota, _, err := ms.ApiQuota.Get(ctx)
+	if err != nil {
+		err = sdkclient.WrapError(err)
+		if statusCode(err) == http.StatusUnauthorized {
+			return fmt.Errorf("the token from %s is invalid or expired", source)
+		}
+		return err
+	}
+
+	access := []string{}
+	for _, p := range accessProbes {
+		err := sdkclient.WrapError(p.check(ctx, ms))
+		switch statusCode(err) {
+		case 0:
+			if err != nil {
+				return err
+			}
+			access = append(access, p.scope)
+		case http.StatusForbidden, http.StatusNotFound:
+		default:
+			return err
+		}
+	}
+
+	result := whoamiResult{
+		Source:    source,
+		Token:     config.MaskToken(ms.APIKey()),
+		Valid:     true,
+		Quota:     quota.Quota,
+		Remaining: quota.Remaining,
+		Access:    access,
+	}
+	if cmdutil.JSONFlag(cmd) {
+		return output.JSON(result)
+	}
+
+	accessText := strings.Join(access, ", ")
+	if accessText == "" {
+		accessText = "none of the checked resources"
+	}
+	output.Render(
+		[]string{"Field", "Value"},
+		[][]string{
+			{"Source", result.Source},
+			{"Token", result.Token},
+			{"Status", "Valid"},
+			{"API Quota", fmt.Sprintf("%d of %d remaining", result.Remaining, result.Quota)},
+			{"Read Access", accessText},
+		},
+	)
+	return nil
+}
+
+// statusCode returns the HTTP status of an API error, or 0 for nil and
+// errors that did not come from an API response.
+func statusCode(err error) int {
+	var cliErr *sdkclient.CLIError
+	if errors.As(err, &cliErr) {
+		return cliErr.StatusCode
+	}
+	return 0
+}
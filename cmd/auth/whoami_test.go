@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWhoamiCmd_ReportsAccess(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api-quota":
+			w.Write([]byte(`{"quota":100000,"remaining":99000,"reset":"2026-11-01T00:00:00Z"}`)) //nolint:errcheck
+		case "/domains", "/templates":
+			w.Write([]byte(`{"data":[],"links":{},"meta":{}}`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message":"This action is unauthorized."}`)) //nolint:errcheck
+		}
+	}))
+	defer server.Close()
+	t.Setenv("MAILERSEND_API_TOKEN", "mlsn.whoami-test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"auth", "whoami"})
+
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	err = root.Execute()
+	os.Stdout = origStdout
+	w.Close() //nolint:errcheck
+	if err != nil {
+		t.Fatalf("whoami failed: %v", err)
+	}
+	out, _ := io.ReadAll(r)
+
+	var got whoamiResult
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	if !got.Valid || got.Source != "MAILERSEND_API_TOKEN" || got.Remaining != 99000 {
+		t.Errorf("result = %+v", got)
+	}
+	if strings.Join(got.Access, ",") != "domains_read,templates_full" {
+		t.Errorf("access = %v, want [domains_read templates_full]", got.Access)
+	}
+	if strings.Contains(got.Token, "whoami-test") {
+		t.Errorf("token %q is not masked", got.Token)
+	}
+}
+
+func TestWhoamiCmd_InvalidToken(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"Unauthenticated."}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+	t.Setenv("MAILERSEND_API_TOKEN", "mlsn.revoked")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"auth", "whoami"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid or expired") {
+		t.Fatalf("expected an invalid token error, got %v", err)
+	}
+}
@@ -90,11 +90,11 @@ var statusCmd = &cobra.Command{
 		d := result.Data
 		createdAt := ""
 		if !d.CreatedAt.IsZero() {
-			createdAt = d.CreatedAt.Format("2006-01-02 15:04:05")
+			createdAt = output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")
 		}
 		updatedAt := ""
 		if !d.UpdatedAt.IsZero() {
-			updatedAt = d.UpdatedAt.Format("2006-01-02 15:04:05")
+			updatedAt = output.FormatTime(d.UpdatedAt, "2006-01-02 15:04:05")
 		}
 
 		headers := []string{"FIELD", "VALUE"}
@@ -108,7 +108,7 @@ var statusCmd = &cobra.Command{
 			{"Created At", createdAt},
 			{"Updated At", updatedAt},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -0,0 +1,190 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	appconfig "github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/profiles"
+	"github.com/spf13/cobra"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and change config settings",
+	Long:  "Read and change settings in the config file without editing it by hand.\n\nValid keys:\n" + keyHelp(),
+}
+
+var getCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the value of a config key",
+	Long:  "Print the value of a config key. Unset keys print an empty line.\n\nValid keys:\n" + keyHelp(),
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGet,
+}
+
+var setCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a config key",
+	Long:  "Set a config key. An empty value (\"\") unsets it.\n\nValid keys:\n" + keyHelp(),
+	Example: `  mailersend config set default_output json
+  mailersend config set theme.primary "#1e90ff"
+  mailersend config set base_url ""`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSet,
+}
+
+var setDefaultDomainCmd = &cobra.Command{
+	Use:   "set-default-domain <domain>",
+	Short: "Set the profile's default domain",
+	Long: `Resolve a domain name or ID and store it as the default domain of the
+profile selected with --profile (or the active one). Commands that require
+--domain use it when the flag is not given. An empty value ("") clears it.`,
+	Example: `  mailersend config set-default-domain yourdomain.com
+  mailersend config set-default-domain yourdomain.com --profile staging
+  mailersend config set-default-domain ""`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSetDefaultDomain,
+}
+
+var clearCacheCmd = &cobra.Command{
+	Use:   "clear-cache",
+	Short: "Delete the domain name cache",
+	Long:  "Delete the cached domain name to ID mappings of all profiles. The next command that resolves a domain name lists domains again.",
+	Args:  cobra.NoArgs,
+	RunE:  runClearCache,
+}
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "List, switch, rename, and delete profiles",
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List profiles with their auth method and masked token",
+	RunE:  profiles.List,
+}
+
+var profileUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Make a profile the active one",
+	Args:  cobra.ExactArgs(1),
+	RunE:  profiles.Switch,
+}
+
+var profileRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a profile",
+	Args:  cobra.ExactArgs(2),
+	RunE:  profiles.Rename,
+}
+
+var profileDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a profile",
+	Long:  "Delete a profile. If it was active, the first remaining profile by name becomes active.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  profiles.Remove,
+}
+
+func init() {
+	profileCmd.AddCommand(profileListCmd, profileUseCmd, profileRenameCmd, profileDeleteCmd)
+	Cmd.AddCommand(getCmd, setCmd, setDefaultDomainCmd, clearCacheCmd, profileCmd)
+}
+
+// keyHelp lists the valid config keys for help text.
+func keyHelp() string {
+	var b strings.Builder
+	for _, k := range appconfig.Keys {
+		fmt.Fprintf(&b, "  %-16s %s\n", k.Name, k.Description)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func runGet(cmd *cobra.Command, args []string) error {
+	cfg, err := appconfig.Load()
+	if err != nil {
+		return err
+	}
+	value, err := cfg.GetKey(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), value)
+	return nil
+}
+
+func runSet(cmd *cobra.Command, args []string) error {
+	cfg, err := appconfig.Load()
+	if err != nil {
+		return err
+	}
+	if err := cfg.SetKey(args[0], args[1]); err != nil {
+		return err
+	}
+	if err := appconfig.Save(cfg); err != nil {
+		return err
+	}
+
+	if args[1] == "" {
+		output.Success(fmt.Sprintf("Unset %s.", args[0]))
+	} else {
+		output.Success(fmt.Sprintf("Set %s to %s.", args[0], args[1]))
+	}
+	return nil
+}
+
+func runSetDefaultDomain(cmd *cobra.Command, args []string) error {
+	cfg, err := appconfig.Load()
+	if err != nil {
+		return err
+	}
+	name := cmdutil.ProfileFlag(cmd)
+	if name == "" {
+		if name, _, err = appconfig.ActiveProfile(cfg); err != nil {
+			return err
+		}
+	}
+	prof, ok := cfg.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	domainID := args[0]
+	if domainID != "" {
+		ms, err := cmdutil.NewSDKClient(cmd)
+		if err != nil {
+			return err
+		}
+		if domainID, err = cmdutil.ResolveDomainSDK(ms, domainID); err != nil {
+			return err
+		}
+	}
+
+	prof.DefaultDomain = domainID
+	cfg.Profiles[name] = prof
+	if err := appconfig.Save(cfg); err != nil {
+		return err
+	}
+
+	switch {
+	case domainID == "":
+		output.Success(fmt.Sprintf("Cleared the default domain of profile %q.", name))
+	case domainID == args[0]:
+		output.Success(fmt.Sprintf("Default domain of profile %q set to %s.", name, domainID))
+	default:
+		output.Success(fmt.Sprintf("Default domain of profile %q set to %s (%s).", name, args[0], domainID))
+	}
+	return nil
+}
+
+func runClearCache(cmd *cobra.Command, args []string) error {
+	if err := cmdutil.ClearDomainCache(); err != nil {
+		return fmt.Errorf("failed to clear the domain cache: %w", err)
+	}
+	output.Success("Domain cache cleared.")
+	return nil
+}
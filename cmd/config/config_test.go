@@ -0,0 +1,260 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	appconfig "github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func TestConfigSetGet_DefaultOutputRoundTrip(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "set", "default_output", "json"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config set: %v", err)
+	}
+
+	cfg, err := appconfig.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.DefaultOutput != "json" {
+		t.Errorf("DefaultOutput = %q, want %q", cfg.DefaultOutput, "json")
+	}
+
+	var out bytes.Buffer
+	root = newRootCmd()
+	root.SetOut(&out)
+	root.SetArgs([]string{"config", "get", "default_output"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config get: %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "json" {
+		t.Errorf("config get default_output = %q, want %q", got, "json")
+	}
+}
+
+func TestConfigSet_RejectsInvalidValue(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "set", "default_output", "xml"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "table, json, yaml, or csv") {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestConfigGet_UnknownKey(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "get", "nope"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "valid keys") {
+		t.Fatalf("expected an unknown key error, got %v", err)
+	}
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestConfigProfile_UseAndList(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := &appconfig.Config{
+		ActiveProfile: "work",
+		Profiles: map[string]appconfig.Profile{
+			"work":     {APIToken: "mlsn_work_token_1234"},
+			"personal": {OAuthToken: "oauth_personal_token_5678"},
+		},
+	}
+	if err := appconfig.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "profile", "use", "personal"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config profile use: %v", err)
+	}
+	cfg, err := appconfig.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ActiveProfile != "personal" {
+		t.Errorf("ActiveProfile = %q, want %q", cfg.ActiveProfile, "personal")
+	}
+
+	root = newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"config", "profile", "list"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("config profile list: %v", err)
+		}
+	})
+
+	var profiles []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &profiles); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, out)
+	}
+	if len(profiles) != 2 || profiles[0]["name"] != "personal" || profiles[1]["name"] != "work" {
+		t.Fatalf("unexpected profiles: %v", profiles)
+	}
+	if profiles[0]["active"] != true || profiles[0]["method"] != "oauth" {
+		t.Errorf("personal = %v, want active oauth profile", profiles[0])
+	}
+	if profiles[1]["token"] != "mlsn_wo...1234" {
+		t.Errorf("work token = %v, want it masked", profiles[1]["token"])
+	}
+	if strings.Contains(out, "mlsn_work_token_1234") {
+		t.Error("output contains the unmasked token")
+	}
+}
+
+func TestConfigProfile_UseUnknown(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "profile", "use", "missing"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestConfigProfile_RenameAndDelete(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	// With stdin on a pipe, delete does not ask for confirmation.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close() //nolint:errcheck
+	w.Close()       //nolint:errcheck
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+
+	cfg := &appconfig.Config{
+		ActiveProfile: "work",
+		Profiles: map[string]appconfig.Profile{
+			"work":     {APIToken: "work-token"},
+			"personal": {APIToken: "personal-token"},
+		},
+	}
+	if err := appconfig.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "profile", "rename", "work", "personal"})
+	if err := root.Execute(); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected an already exists error, got %v", err)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"config", "profile", "rename", "work", "acme"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config profile rename: %v", err)
+	}
+	if cfg, _ = appconfig.Load(); cfg.ActiveProfile != "acme" || cfg.Profiles["acme"].APIToken != "work-token" {
+		t.Fatalf("after rename: active %q, profiles %v", cfg.ActiveProfile, cfg.Profiles)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"config", "profile", "delete", "acme"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config profile delete: %v", err)
+	}
+	if cfg, _ = appconfig.Load(); cfg.ActiveProfile != "personal" || len(cfg.Profiles) != 1 {
+		t.Fatalf("after delete: active %q, profiles %v", cfg.ActiveProfile, cfg.Profiles)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"config", "profile", "delete", "acme"})
+	if err := root.Execute(); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestConfigSetDefaultDomain_ResolvesAndStoresID(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domains" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"dom-1","name":"example.com"}],"links":{},"meta":{}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	cfg := &appconfig.Config{
+		ActiveProfile: "work",
+		Profiles:      map[string]appconfig.Profile{"work": {APIToken: "work-token"}},
+	}
+	if err := appconfig.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	root := newRootCmd()
+	root.SetArgs([]string{"config", "set-default-domain", "example.com"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config set-default-domain: %v", err)
+	}
+	if cfg, _ = appconfig.Load(); cfg.Profiles["work"].DefaultDomain != "dom-1" {
+		t.Errorf("DefaultDomain = %q, want %q", cfg.Profiles["work"].DefaultDomain, "dom-1")
+	}
+	if cfg.Profiles["work"].APIToken != "work-token" {
+		t.Error("setting the default domain changed the profile's token")
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"config", "set-default-domain", ""})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config set-default-domain \"\": %v", err)
+	}
+	if cfg, _ = appconfig.Load(); cfg.Profiles["work"].DefaultDomain != "" {
+		t.Errorf("DefaultDomain = %q, want it cleared", cfg.Profiles["work"].DefaultDomain)
+	}
+}
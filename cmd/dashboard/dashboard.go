@@ -4,6 +4,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/tui"
+	"github.com/mailersend/mailersend-cli/internal/tui/views"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +18,8 @@ The dashboard provides a lazygit-style interface with:
   - Sidebar navigation between views
   - Vim-style keybindings (j/k to navigate, Enter to select)
   - Real-time data from your MailerSend account
+  - e / E to export the current view to CSV / JSON under the config
+    directory's exports/ folder
 
 Press ? for help or q to quit.`,
 	RunE: runDashboard,
@@ -33,6 +36,7 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 		profile = "default"
 	}
 
+	views.SetFetchTimeout(cmdutil.TimeoutFlag(cmd))
 	app := tui.NewApp(client, profile)
 
 	p := tea.NewProgram(app, tea.WithAltScreen())
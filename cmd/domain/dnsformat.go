@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dnsRecord is one record returned by the DNS endpoint.
+type dnsRecord struct {
+	name     string
+	hostname string
+	rtype    string
+	value    string
+}
+
+// txtChunkSize is the longest character-string a TXT record can hold.
+const txtChunkSize = 255
+
+// txtChunks splits v into strings of at most txtChunkSize bytes. Resolvers
+// join them back together, so DKIM keys longer than one string still work.
+func txtChunks(v string) []string {
+	var chunks []string
+	for len(v) > txtChunkSize {
+		chunks = append(chunks, v[:txtChunkSize])
+		v = v[txtChunkSize:]
+	}
+	return append(chunks, v)
+}
+
+// fqdn returns host as an absolute domain name with a trailing dot.
+func fqdn(host string) string {
+	if strings.HasSuffix(host, ".") {
+		return host
+	}
+	return host + "."
+}
+
+// zoneLines formats records as BIND zone file lines. TXT values are split
+// into quoted 255-byte strings with quotes and backslashes escaped, and
+// CNAME targets are made absolute. Records without a value are skipped.
+func zoneLines(records []dnsRecord, ttl int) string {
+	var b strings.Builder
+	for _, r := range records {
+		if r.hostname == "" || r.value == "" {
+			continue
+		}
+		rtype := strings.ToUpper(r.rtype)
+		rdata := r.value
+		switch rtype {
+		case "TXT":
+			escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+			var quoted []string
+			for _, chunk := range txtChunks(r.value) {
+				quoted = append(quoted, `"`+escape.Replace(chunk)+`"`)
+			}
+			rdata = strings.Join(quoted, " ")
+		case "CNAME":
+			rdata = fqdn(r.value)
+		}
+		fmt.Fprintf(&b, "; %s\n%s\t%d\tIN\t%s\t%s\n", r.name, fqdn(r.hostname), ttl, rtype, rdata)
+	}
+	return b.String()
+}
+
+// hclString quotes s as a Terraform string literal, escaping the template
+// sequences "${" and "%{" so values are taken literally.
+func hclString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", "$${", "%{", "%%{").Replace(s) + `"`
+}
+
+// terraformBlocks formats records as Terraform resource blocks for the given
+// provider ("cloudflare" or "route53"), reading the zone ID from a variable.
+// Cloudflare splits long TXT values itself; Route 53 needs the 255-byte
+// strings separated by "" inside the single record value.
+func terraformBlocks(records []dnsRecord, ttl int, provider string) string {
+	var blocks []string
+	for _, r := range records {
+		if r.hostname == "" || r.value == "" {
+			continue
+		}
+		rtype := strings.ToUpper(r.rtype)
+		label := "mailersend_" + strings.ReplaceAll(strings.ToLower(r.name), " ", "_")
+
+		var b strings.Builder
+		if provider == "route53" {
+			value := hclString(r.value)
+			if rtype == "TXT" {
+				var parts []string
+				for _, chunk := range txtChunks(r.value) {
+					q := hclString(chunk)
+					parts = append(parts, q[1:len(q)-1])
+				}
+				value = `"` + strings.Join(parts, `\"\"`) + `"`
+			}
+			fmt.Fprintf(&b, "resource \"aws_route53_record\" %q {\n", label)
+			fmt.Fprintf(&b, "  zone_id = var.route53_zone_id\n")
+			fmt.Fprintf(&b, "  name    = %s\n", hclString(r.hostname))
+			fmt.Fprintf(&b, "  type    = %s\n", hclString(rtype))
+			fmt.Fprintf(&b, "  ttl     = %d\n", ttl)
+			fmt.Fprintf(&b, "  records = [%s]\n", value)
+		} else {
+			fmt.Fprintf(&b, "resource \"cloudflare_record\" %q {\n", label)
+			fmt.Fprintf(&b, "  zone_id = var.cloudflare_zone_id\n")
+			fmt.Fprintf(&b, "  name    = %s\n", hclString(r.hostname))
+			fmt.Fprintf(&b, "  type    = %s\n", hclString(rtype))
+			fmt.Fprintf(&b, "  content = %s\n", hclString(r.value))
+			fmt.Fprintf(&b, "  ttl     = %d\n", ttl)
+		}
+		b.WriteString("}\n")
+		blocks = append(blocks, b.String())
+	}
+	return strings.Join(blocks, "\n")
+}
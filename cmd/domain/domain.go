@@ -2,8 +2,16 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"fmt"
-
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/mailersend/mailersend-cli/internal/analytics"
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/prompt"
@@ -34,6 +42,17 @@ func boolCheck(b bool) string {
 	return "\u2717"
 }
 
+// verifyResult is the --json shape of domain verify: the API response plus a
+// single flag that CI can gate on.
+type verifyResult struct {
+	*mailersend.VerifyRoot
+	AllVerified bool `json:"all_verified"`
+}
+
+func allVerified(v mailersend.Verify) bool {
+	return v.Dkim && v.Spf && v.Mx && v.Tracking && v.Cname && v.RpCname
+}
+
 // --- Subcommands ---
 
 func init() {
@@ -44,15 +63,28 @@ func init() {
 	Cmd.AddCommand(updateSettingsCmd)
 	Cmd.AddCommand(dnsCmd)
 	Cmd.AddCommand(verifyCmd)
+	Cmd.AddCommand(statsCmd)
+	Cmd.AddCommand(analyticsCmd)
+
+	// analytics flags
+	analyticsCmd.Flags().String("since", "-7d", "start of the range: a relative duration like -7d, 2w, or 24h, or a date")
+	analyticsCmd.Flags().StringSlice("event", defaultAnalyticsEvents, "event types to retrieve")
+	analyticsCmd.Flags().String("group-by", "", "group by: days, weeks, months, years")
 
 	// list flags
 	listCmd.Flags().Int("limit", 0, "maximum number of domains to return (0 = all)")
+	cmdutil.AddPageFlags(listCmd)
 	listCmd.Flags().Bool("verified", false, "filter by verified status")
 
+	// delete flags
+	deleteCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt")
+
 	// add flags
 	addCmd.Flags().String("name", "", "domain name (required)")
 	addCmd.Flags().String("return-path-subdomain", "", "custom return path subdomain")
 	addCmd.Flags().String("custom-tracking-subdomain", "", "custom tracking subdomain")
+	addCmd.Flags().Bool("no-precheck", false, "skip checking whether the domain already exists before adding it")
+	addCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
 
 	// update-settings flags
 	updateSettingsCmd.Flags().Bool("send-paused", false, "pause sending")
@@ -64,6 +96,19 @@ func init() {
 	updateSettingsCmd.Flags().String("custom-tracking-subdomain", "", "custom tracking subdomain")
 	updateSettingsCmd.Flags().Bool("precedence-bulk", false, "set precedence bulk header")
 	updateSettingsCmd.Flags().Bool("ignore-duplicated-recipients", false, "ignore duplicated recipients")
+
+	// verify flags
+	verifyCmd.Flags().Bool("require-all", false, "exit with an error if any record is unverified")
+	verifyCmd.Flags().Bool("all-pending", false, "verify every domain that is not yet verified")
+	verifyCmd.Flags().Bool("all", false, "alias for --all-pending")
+	verifyCmd.Flags().Bool("wait", false, "re-check until all records verify")
+	cmdutil.AddWaitFlags(verifyCmd, 30*time.Second, 10*time.Minute)
+
+	// dns flags
+	dnsCmd.Flags().String("copy", "", "copy a record's value to the clipboard: spf, dkim, return-path, or custom-tracking")
+	dnsCmd.Flags().String("format", "table", "output format: table, zone (BIND zone file lines), or terraform")
+	dnsCmd.Flags().Int("ttl", 3600, "record TTL in seconds for --format zone and terraform")
+	dnsCmd.Flags().String("provider", "cloudflare", "Terraform provider for --format terraform: cloudflare or route53")
 }
 
 // list
@@ -86,7 +131,7 @@ var listCmd = &cobra.Command{
 			verifiedFilter = mailersend.Bool(verified)
 		}
 
-		domains, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
+		domains, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
 			root, _, err := ms.Domain.List(ctx, &mailersend.ListDomainOptions{
 				Page:     page,
 				Limit:    perPage,
@@ -113,11 +158,11 @@ var listCmd = &cobra.Command{
 				d.Name,
 				boolYesNo(d.IsVerified),
 				boolYesNo(d.IsDNSActive),
-				d.CreatedAt,
+				output.FormatTimeString(d.CreatedAt),
 			})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -158,11 +203,11 @@ var getCmd = &cobra.Command{
 			{"DKIM", boolYesNo(d.Dkim)},
 			{"Tracking", boolYesNo(d.Tracking)},
 			{"DNS Active", boolYesNo(d.IsDNSActive)},
-			{"Created", d.CreatedAt},
-			{"Updated", d.UpdatedAt},
+			{"Created", output.FormatTimeString(d.CreatedAt)},
+			{"Updated", output.FormatTimeString(d.UpdatedAt)},
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -184,6 +229,19 @@ var addCmd = &cobra.Command{
 		}
 		returnPath, _ := c.Flags().GetString("return-path-subdomain")
 		customTracking, _ := c.Flags().GetString("custom-tracking-subdomain")
+		noPrecheck, _ := c.Flags().GetBool("no-precheck")
+
+		ctx := context.Background()
+
+		if !noPrecheck {
+			existing, err := findDomainByName(ctx, ms, name)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return fmt.Errorf("domain %s already exists (ID: %s)", existing.Name, existing.ID)
+			}
+		}
 
 		opts := &mailersend.CreateDomainOptions{
 			Name: name,
@@ -195,11 +253,11 @@ var addCmd = &cobra.Command{
 			opts.CustomTrackingSubdomain = customTracking
 		}
 
-		ctx := context.Background()
 		result, _, err := ms.Domain.Create(ctx, opts)
 		if err != nil {
 			return sdkclient.WrapError(err)
 		}
+		cmdutil.InvalidateDomainCache(ms)
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(result)
@@ -207,10 +265,44 @@ var addCmd = &cobra.Command{
 
 		d := result.Data
 		output.Success(fmt.Sprintf("Domain created successfully: %s (ID: %s)", d.Name, d.ID))
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(d)
+		}
 		return nil
 	},
 }
 
+// listDomains fetches every domain in the account, or only verified or
+// unverified ones when verified is non-nil.
+func listDomains(ctx context.Context, ms *mailersend.Mailersend, verified *bool) ([]mailersend.Domain, error) {
+	return sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
+		root, _, err := ms.Domain.List(ctx, &mailersend.ListDomainOptions{
+			Page:     page,
+			Limit:    perPage,
+			Verified: verified,
+		})
+		if err != nil {
+			return nil, false, sdkclient.WrapError(err)
+		}
+		return root.Data, root.Links.Next != "", nil
+	}, 0)
+}
+
+// findDomainByName lists all domains and returns the one named name, or nil
+// if there is none.
+func findDomainByName(ctx context.Context, ms *mailersend.Mailersend, name string) (*mailersend.Domain, error) {
+	domains, err := listDomains(ctx, ms, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for existing domain: %w", err)
+	}
+	for i := range domains {
+		if strings.EqualFold(domains[i].Name, name) {
+			return &domains[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // delete
 var deleteCmd = &cobra.Command{
 	Use:   "delete <domain_id_or_name>",
@@ -227,11 +319,21 @@ var deleteCmd = &cobra.Command{
 			return err
 		}
 
+		yes, _ := c.Flags().GetBool("yes")
+		if err := prompt.ConfirmDestructive(yes, fmt.Sprintf("Delete domain %s and all of its settings?", args[0])); err != nil {
+			return err
+		}
+
 		ctx := context.Background()
 		_, err = ms.Domain.Delete(ctx, domainID)
 		if err != nil {
 			return sdkclient.WrapError(err)
 		}
+		cmdutil.InvalidateDomainCache(ms)
+
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("domain", domainID)
+		}
 
 		output.Success(fmt.Sprintf("Domain %s deleted successfully.", args[0]))
 		return nil
@@ -330,8 +432,36 @@ var updateSettingsCmd = &cobra.Command{
 var dnsCmd = &cobra.Command{
 	Use:   "dns <domain_id_or_name>",
 	Short: "Show DNS records for a domain",
-	Args:  cobra.ExactArgs(1),
+	Long: `Show the DNS records a domain needs.
+
+--format zone prints BIND zone file lines ready for a DNS provider's import,
+with TXT values quoted and split into 255-character strings. --format
+terraform prints cloudflare_record (or, with --provider route53,
+aws_route53_record) resource blocks. Records without a value are skipped in
+both formats.`,
+	Example: `  mailersend domain dns example.com
+  mailersend domain dns example.com --format zone > mailersend.zone
+  mailersend domain dns example.com --format terraform --provider route53`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(c *cobra.Command, args []string) error {
+		format, _ := c.Flags().GetString("format")
+		ttl, _ := c.Flags().GetInt("ttl")
+		provider, _ := c.Flags().GetString("provider")
+		switch format {
+		case "table", "zone", "terraform":
+		default:
+			return fmt.Errorf("invalid --format %q: use table, zone, or terraform", format)
+		}
+		if format != "table" && cmdutil.JSONFlag(c) {
+			return fmt.Errorf("--format %s cannot be combined with --json", format)
+		}
+		if ttl <= 0 {
+			return fmt.Errorf("--ttl must be positive")
+		}
+		if provider != "cloudflare" && provider != "route53" {
+			return fmt.Errorf("invalid --provider %q: use cloudflare or route53", provider)
+		}
+
 		ms, err := cmdutil.NewSDKClient(c)
 		if err != nil {
 			return err
@@ -348,61 +478,420 @@ var dnsCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
-		if cmdutil.JSONFlag(c) {
-			return output.JSON(result)
-		}
-
 		dns := result.Data
-		headers := []string{"RECORD", "HOSTNAME", "TYPE", "VALUE"}
-		rows := [][]string{
+		records := []dnsRecord{
 			{"SPF", dns.Spf.Hostname, dns.Spf.Type, dns.Spf.Value},
 			{"DKIM", dns.Dkim.Hostname, dns.Dkim.Type, dns.Dkim.Value},
 			{"Return Path", dns.ReturnPath.Hostname, dns.ReturnPath.Type, dns.ReturnPath.Value},
 			{"Custom Tracking", dns.CustomTracking.Hostname, dns.CustomTracking.Type, dns.CustomTracking.Value},
 		}
+		headers := []string{"RECORD", "HOSTNAME", "TYPE", "VALUE"}
+		var rows [][]string
+		for _, r := range records {
+			rows = append(rows, []string{r.name, r.hostname, r.rtype, r.value})
+		}
 
-		output.Table(headers, rows)
+		if record, _ := c.Flags().GetString("copy"); record != "" {
+			return copyDNSRecord(rows, record)
+		}
+
+		switch format {
+		case "zone":
+			fmt.Print(zoneLines(records, ttl))
+			return nil
+		case "terraform":
+			fmt.Print(terraformBlocks(records, ttl, provider))
+			return nil
+		}
+
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(result)
+		}
+
+		output.Render(headers, rows)
 		return nil
 	},
 }
 
+// copyToClipboard is swapped out in tests.
+var copyToClipboard = writeClipboard
+
+// errNoGraphicalSession is returned by writeClipboard in a headless session,
+// such as over SSH, where the clipboard tools would only fail opaquely.
+var errNoGraphicalSession = errors.New("no clipboard available: no graphical session (DISPLAY and WAYLAND_DISPLAY are unset)")
+
+// writeClipboard copies text to the system clipboard. Outside macOS and
+// Windows the clipboard needs an X11 or Wayland session.
+func writeClipboard(text string) error {
+	if runtime.GOOS != "darwin" && runtime.GOOS != "windows" &&
+		os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		return errNoGraphicalSession
+	}
+	return clipboard.WriteAll(text)
+}
+
+// copyDNSRecord copies the value of the named DNS record to the clipboard.
+// Names match the RECORD column case-insensitively, with "-" or "_" for
+// spaces, e.g. "dkim" or "return-path".
+func copyDNSRecord(rows [][]string, record string) error {
+	normalize := func(s string) string {
+		return strings.NewReplacer(" ", "-", "_", "-").Replace(strings.ToLower(strings.TrimSpace(s)))
+	}
+	var names []string
+	for _, row := range rows {
+		if normalize(row[0]) != normalize(record) {
+			names = append(names, normalize(row[0]))
+			continue
+		}
+		if row[3] == "" {
+			return fmt.Errorf("the %s record has no value to copy", row[0])
+		}
+		if err := copyToClipboard(row[3]); err != nil {
+			return fmt.Errorf("could not copy the %s value: %w", row[0], err)
+		}
+		output.Success(fmt.Sprintf("Copied the %s value to the clipboard.", row[0]))
+		return nil
+	}
+	return fmt.Errorf("unknown DNS record %q (valid records: %s)", record, strings.Join(names, ", "))
+}
+
 // verify
 var verifyCmd = &cobra.Command{
-	Use:   "verify <domain_id_or_name>",
+	Use:   "verify [domain_id_or_name]",
 	Short: "Verify a domain",
-	Args:  cobra.ExactArgs(1),
+	Long: `Check a domain's DNS records.
+
+With --all-pending every unverified domain in the account is checked in
+turn and a per-domain summary is printed; a failure on one domain does not
+stop the others; --all is an alias. With --wait the domains still pending
+are re-checked every --interval, printing the records still unverified,
+until all of them verify or --wait-timeout passes; the timeout covers the
+whole run, not each domain. The exit code is non-zero if any
+domain failed to verify or still has unverified records, as if
+--require-all were given.`,
+	Example: `  mailersend domain verify example.com
+  mailersend domain verify example.com --wait --interval 30s
+  mailersend domain verify --all-pending --wait`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runVerify,
+}
+
+func runVerify(c *cobra.Command, args []string) error {
+	allPending, _ := c.Flags().GetBool("all-pending")
+	if all, _ := c.Flags().GetBool("all"); all {
+		allPending = true
+	}
+	if allPending && len(args) > 0 {
+		return fmt.Errorf("--all-pending cannot be combined with a domain argument")
+	}
+	if !allPending && len(args) == 0 {
+		return fmt.Errorf("a domain ID or name is required (or pass --all-pending)")
+	}
+
+	ms, err := cmdutil.NewSDKClient(c)
+	if err != nil {
+		return err
+	}
+	if allPending {
+		return runVerifyAllPending(c, ms)
+	}
+
+	domainID, err := cmdutil.ResolveDomainSDK(ms, args[0])
+	if err != nil {
+		return err
+	}
+
+	result, err := verifyDomain(context.Background(), c, ms, domainID, args[0])
+	if result == nil {
+		return err
+	}
+
+	verified := allVerified(result.Data)
+	requireAll, _ := c.Flags().GetBool("require-all")
+
+	if cmdutil.JSONFlag(c) {
+		if err := output.JSON(verifyResult{VerifyRoot: result, AllVerified: verified}); err != nil {
+			return err
+		}
+	} else {
+		headers := []string{"RECORD", "STATUS"}
+		rows := [][]string{
+			{"DKIM", boolCheck(result.Data.Dkim)},
+			{"SPF", boolCheck(result.Data.Spf)},
+			{"MX", boolCheck(result.Data.Mx)},
+			{"Tracking", boolCheck(result.Data.Tracking)},
+			{"CNAME", boolCheck(result.Data.Cname)},
+			{"Return Path CNAME", boolCheck(result.Data.RpCname)},
+		}
+
+		output.Render(headers, rows)
+	}
+
+	// A --wait timeout still shows the records as of the last check.
+	if err != nil {
+		return err
+	}
+	if requireAll && !verified {
+		return fmt.Errorf("domain %s has unverified DNS records", args[0])
+	}
+	return nil
+}
+
+// verifyDomain runs a verification check on domainID. With --wait it
+// repeats the check until every record verifies, returning the last result
+// alongside any timeout error. label names the domain in progress notes.
+func verifyDomain(ctx context.Context, c *cobra.Command, ms *mailersend.Mailersend, domainID, label string) (*mailersend.VerifyRoot, error) {
+	wait, _ := c.Flags().GetBool("wait")
+	var result *mailersend.VerifyRoot
+	check := func(ctx context.Context) (bool, error) {
+		r, _, err := ms.Domain.Verify(ctx, domainID)
+		if err != nil {
+			return false, sdkclient.WrapError(err)
+		}
+		result = r
+		done := allVerified(r.Data)
+		if wait && !done {
+			output.Note(fmt.Sprintf("Waiting for %s... (unverified: %s)", label, strings.Join(unverifiedRecords(r.Data), ", ")))
+		}
+		return done, nil
+	}
+
+	if !wait {
+		_, err := check(ctx)
+		return result, err
+	}
+	interval, timeout := cmdutil.WaitFlags(c)
+	err := cmdutil.Poll(ctx, interval, timeout, check)
+	return result, err
+}
+
+// unverifiedRecords names the DNS records that did not verify.
+func unverifiedRecords(v mailersend.Verify) []string {
+	var names []string
+	for _, r := range []struct {
+		name string
+		ok   bool
+	}{
+		{"DKIM", v.Dkim},
+		{"SPF", v.Spf},
+		{"MX", v.Mx},
+		{"Tracking", v.Tracking},
+		{"CNAME", v.Cname},
+		{"Return Path CNAME", v.RpCname},
+	} {
+		if !r.ok {
+			names = append(names, r.name)
+		}
+	}
+	return names
+}
+
+// pendingVerifyResult is one domain's outcome in verify --all-pending.
+type pendingVerifyResult struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	AllVerified bool     `json:"all_verified"`
+	Unverified  []string `json:"unverified,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+func runVerifyAllPending(c *cobra.Command, ms *mailersend.Mailersend) error {
+	ctx := context.Background()
+	domains, err := listDomains(ctx, ms, mailersend.Bool(false))
+	if err != nil {
+		return err
+	}
+
+	results := []pendingVerifyResult{}
+	for _, d := range domains {
+		if !d.IsVerified {
+			results = append(results, pendingVerifyResult{ID: d.ID, Name: d.Name})
+		}
+	}
+
+	// Every pending domain is re-checked in the same pass, so with --wait
+	// they all share one --wait-timeout rather than each getting its own.
+	wait, _ := c.Flags().GetBool("wait")
+	done := make([]bool, len(results))
+	check := func(ctx context.Context) (bool, error) {
+		remaining := 0
+		for i := range results {
+			if done[i] {
+				continue
+			}
+			r := &results[i]
+			v, _, err := ms.Domain.Verify(ctx, r.ID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return false, err
+				}
+				r.Error = sdkclient.WrapError(err).Error()
+				done[i] = true
+				continue
+			}
+			r.AllVerified = allVerified(v.Data)
+			r.Unverified = unverifiedRecords(v.Data)
+			if r.AllVerified || !wait {
+				done[i] = true
+				continue
+			}
+			remaining++
+			output.Note(fmt.Sprintf("Waiting for %s... (unverified: %s)", r.Name, strings.Join(r.Unverified, ", ")))
+		}
+		return remaining == 0, nil
+	}
+
+	if wait {
+		interval, timeout := cmdutil.WaitFlags(c)
+		if err := cmdutil.Poll(ctx, interval, timeout, check); err != nil {
+			for i := range results {
+				if !done[i] {
+					results[i].Error = err.Error()
+				}
+			}
+		}
+	} else if _, err := check(ctx); err != nil {
+		return err
+	}
+
+	failed, unverified := 0, 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		} else if !r.AllVerified {
+			unverified++
+		}
+	}
+
+	if cmdutil.JSONFlag(c) {
+		if err := output.JSON(results); err != nil {
+			return err
+		}
+	} else if len(results) == 0 {
+		output.Success("No pending domains.")
+	} else {
+		headers := []string{"DOMAIN", "ID", "STATUS", "DETAILS"}
+		var rows [][]string
+		for _, r := range results {
+			status, details := "verified", ""
+			switch {
+			case r.Error != "":
+				status, details = "error", r.Error
+			case !r.AllVerified:
+				status, details = "pending", "unverified: "+strings.Join(r.Unverified, ", ")
+			}
+			rows = append(rows, []string{r.Name, r.ID, status, details})
+		}
+		output.Render(headers, rows)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pending domains could not be verified", failed, len(results))
+	}
+	if unverified > 0 {
+		return fmt.Errorf("%d of %d pending domains have unverified DNS records", unverified, len(results))
+	}
+	return nil
+}
+
+// stats
+
+// domainStats is the --json shape of domain stats.
+type domainStats struct {
+	Total       int `json:"total"`
+	Verified    int `json:"verified"`
+	Unverified  int `json:"unverified"`
+	DNSActive   int `json:"dns_active"`
+	DNSInactive int `json:"dns_inactive"`
+}
+
+func countDomains(domains []mailersend.Domain) domainStats {
+	s := domainStats{Total: len(domains)}
+	for _, d := range domains {
+		if d.IsVerified {
+			s.Verified++
+		} else {
+			s.Unverified++
+		}
+		if d.IsDNSActive {
+			s.DNSActive++
+		} else {
+			s.DNSInactive++
+		}
+	}
+	return s
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Count domains by verification and DNS status",
+	Long:  "Fetch every domain in the account and print how many are verified and\nunverified and how many have active DNS, plus the total.",
+	Args:  cobra.NoArgs,
 	RunE: func(c *cobra.Command, args []string) error {
 		ms, err := cmdutil.NewSDKClient(c)
 		if err != nil {
 			return err
 		}
 
-		domainID, err := cmdutil.ResolveDomainSDK(ms, args[0])
+		domains, err := listDomains(context.Background(), ms, nil)
 		if err != nil {
 			return err
 		}
+		s := countDomains(domains)
 
-		ctx := context.Background()
-		result, _, err := ms.Domain.Verify(ctx, domainID)
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(s)
+		}
+
+		output.Render([]string{"STATUS", "COUNT"}, [][]string{
+			{"Verified", strconv.Itoa(s.Verified)},
+			{"Unverified", strconv.Itoa(s.Unverified)},
+			{"DNS active", strconv.Itoa(s.DNSActive)},
+			{"DNS inactive", strconv.Itoa(s.DNSInactive)},
+			{"Total", strconv.Itoa(s.Total)},
+		})
+		return nil
+	},
+}
+
+// analytics
+
+// defaultAnalyticsEvents are the events domain analytics shows unless
+// --event is given.
+var defaultAnalyticsEvents = []string{"sent", "delivered", "opened", "clicked"}
+
+var analyticsCmd = &cobra.Command{
+	Use:   "analytics <domain_id_or_name>",
+	Short: "Show analytics for a domain",
+	Long:  "Show sending analytics by date for a single domain. A shortcut for\n'analytics date --domain <domain>' with a default event set.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		since, _ := c.Flags().GetString("since")
+		now := time.Now()
+		dateFrom, err := cmdutil.ParseSince(since, now)
 		if err != nil {
-			return sdkclient.WrapError(err)
+			return err
 		}
 
-		if cmdutil.JSONFlag(c) {
-			return output.JSON(result)
+		ms, err := cmdutil.NewSDKClient(c)
+		if err != nil {
+			return err
 		}
 
-		headers := []string{"RECORD", "STATUS"}
-		rows := [][]string{
-			{"DKIM", boolCheck(result.Data.Dkim)},
-			{"SPF", boolCheck(result.Data.Spf)},
-			{"MX", boolCheck(result.Data.Mx)},
-			{"Tracking", boolCheck(result.Data.Tracking)},
-			{"CNAME", boolCheck(result.Data.Cname)},
-			{"Return Path CNAME", boolCheck(result.Data.RpCname)},
+		domainID, err := cmdutil.ResolveDomainSDK(ms, args[0])
+		if err != nil {
+			return err
 		}
 
-		output.Table(headers, rows)
-		return nil
+		events, _ := c.Flags().GetStringSlice("event")
+		groupBy, _ := c.Flags().GetString("group-by")
+
+		return analytics.RenderDate(c, ms, &mailersend.AnalyticsOptions{
+			DomainID: domainID,
+			DateFrom: dateFrom,
+			DateTo:   now.Unix(),
+			GroupBy:  groupBy,
+			Event:    events,
+		})
 	},
 }
@@ -2,11 +2,20 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -20,10 +29,32 @@ func newRootCmd() *cobra.Command {
 	return root
 }
 
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
 // ---------- Subcommand registration ----------
 
 func TestDomainCmd_SubcommandsRegistered(t *testing.T) {
-	expected := []string{"list", "get", "add", "delete", "dns", "verify", "update-settings"}
+	expected := []string{"list", "get", "add", "delete", "dns", "verify", "update-settings", "analytics", "stats"}
 
 	cmds := make(map[string]bool)
 	for _, sub := range Cmd.Commands() {
@@ -49,7 +80,7 @@ func TestDomainListCmd_FlagsRegistered(t *testing.T) {
 }
 
 func TestDomainAddCmd_FlagsRegistered(t *testing.T) {
-	flags := []string{"name", "return-path-subdomain", "custom-tracking-subdomain"}
+	flags := []string{"name", "return-path-subdomain", "custom-tracking-subdomain", "no-precheck"}
 	for _, name := range flags {
 		if addCmd.Flags().Lookup(name) == nil {
 			t.Errorf("expected flag %q on domain add command", name)
@@ -171,12 +202,53 @@ func TestDomainGetCmd_MockServer(t *testing.T) {
 	}
 }
 
+func TestDomainGetCmd_FormatsTimestamps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"id":         "domain-id-1",
+				"name":       "example.com",
+				"created_at": "2024-01-01T00:00:00Z",
+				"updated_at": "2024-01-02T00:00:00Z",
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	if err := output.SetTimeFormat("unix"); err != nil {
+		t.Fatal(err)
+	}
+	defer output.SetTimeFormat("") //nolint:errcheck
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "get", "domain-id-1"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "1704067200") || !strings.Contains(out, "1704153600") {
+		t.Errorf("expected unix timestamps under --time-format unix:\n%s", out)
+	}
+}
+
 func TestDomainAddCmd_MockServer(t *testing.T) {
 	var receivedBody map[string]string
 	var receivedMethod string
 	var receivedPath string
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			// Duplicate-name pre-check: no existing domains.
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{}}) //nolint:errcheck
+			return
+		}
+
 		receivedMethod = r.Method
 		receivedPath = r.URL.Path
 
@@ -190,12 +262,12 @@ func TestDomainAddCmd_MockServer(t *testing.T) {
 			},
 		}
 
-		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
 		json.NewEncoder(w).Encode(resp) //nolint:errcheck
 	}))
 	defer server.Close()
 
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
 	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
 	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
 
@@ -228,6 +300,185 @@ func TestDomainAddCmd_MockServer(t *testing.T) {
 	}
 }
 
+func TestDomainAddCmd_ShowCreated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{}}) //nolint:errcheck
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"id":   "new-domain-id",
+				"name": "newdomain.com",
+				"domain_settings": map[string]interface{}{
+					"track_opens":               true,
+					"custom_tracking_subdomain": "email",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() { _ = addCmd.Flags().Set("show-created", "false") }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "add", "--name", "newdomain.com", "--show-created"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"domain_settings.custom_tracking_subdomain", "email", "domain_settings.track_opens", "true", "new-domain-id"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestDomainAddCmd_PrecheckRejectsExistingName(t *testing.T) {
+	var posted bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posted = true
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		resp := map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "existing-id", "name": "example.com"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "add", "--name", "Example.com"})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("expected error for existing domain name")
+	}
+	if !strings.Contains(err.Error(), "already exists") || !strings.Contains(err.Error(), "existing-id") {
+		t.Errorf("expected error naming the existing domain ID, got %v", err)
+	}
+	if posted {
+		t.Error("expected no POST when the domain already exists")
+	}
+}
+
+func TestDomainListCmd_FieldsOrderColumns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "d1", "name": "example.com"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	output.SetFields([]string{"name", "id"})
+	defer output.SetFields(nil)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "list"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var header, row string
+	for _, line := range strings.Split(out, "\n") {
+		switch {
+		case strings.Contains(line, "NAME"):
+			header = line
+		case strings.Contains(line, "example.com"):
+			row = line
+		}
+	}
+	if header == "" || row == "" {
+		t.Fatalf("expected header and row in output, got:\n%s", out)
+	}
+	if strings.Index(header, "NAME") > strings.Index(header, "ID") {
+		t.Errorf("expected NAME before ID, got header %q", header)
+	}
+	if strings.Contains(header, "VERIFIED") {
+		t.Errorf("expected unselected columns to be dropped, got header %q", header)
+	}
+	if strings.Index(row, "example.com") > strings.Index(row, "d1") {
+		t.Errorf("expected name before ID in row, got %q", row)
+	}
+}
+
+func TestDomainAnalyticsCmd_ResolvesDomainAndDefaultEvents(t *testing.T) {
+	var analyticsQuery url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/domains":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{{"id": "dom-1", "name": "example.com"}},
+			})
+		case "/analytics/date":
+			analyticsQuery = r.URL.Query()
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": map[string]interface{}{"stats": []interface{}{}},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "analytics", "example.com", "--since", "-14d", "--json"})
+
+	captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if analyticsQuery == nil {
+		t.Fatal("expected an analytics request")
+	}
+	if got := analyticsQuery.Get("domain_id"); got != "dom-1" {
+		t.Errorf("expected resolved domain_id dom-1, got %q", got)
+	}
+	if got := strings.Join(analyticsQuery["event[]"], ","); got != "sent,delivered,opened,clicked" {
+		t.Errorf("expected default events, got %q", got)
+	}
+	from, _ := strconv.ParseInt(analyticsQuery.Get("date_from"), 10, 64)
+	to, _ := strconv.ParseInt(analyticsQuery.Get("date_to"), 10, 64)
+	if days := (to - from + 3600) / 86400; days != 14 {
+		t.Errorf("expected a 14 day range, got %d days", days)
+	}
+}
+
 func TestDomainListCmd_JSONOutput(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resp := map[string]interface{}{
@@ -257,3 +508,599 @@ func TestDomainListCmd_JSONOutput(t *testing.T) {
 		t.Fatalf("command returned error: %v", err)
 	}
 }
+
+// verifyServer returns a mock server answering domain verify with the given
+// DKIM status and every other record verified.
+func verifyServer(dkim bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]interface{}{
+			"message": "ok",
+			"data": map[string]bool{
+				"dkim":     dkim,
+				"spf":      true,
+				"mx":       true,
+				"tracking": true,
+				"cname":    true,
+				"rp_cname": true,
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+}
+
+func TestDomainVerifyCmd_RequireAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		dkim       bool
+		requireAll bool
+		wantErr    bool
+	}{
+		{"unverified without flag succeeds", false, false, false},
+		{"unverified with require-all fails", false, true, true},
+		{"verified with require-all succeeds", true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := verifyServer(tt.dkim)
+			defer server.Close()
+
+			t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+			t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+			_ = verifyCmd.Flags().Set("require-all", "false")
+
+			args := []string{"domain", "verify", "domain-id-1", "--json"}
+			if tt.requireAll {
+				args = append(args, "--require-all")
+			}
+			root := newRootCmd()
+			root.SetArgs(args)
+
+			err := root.Execute()
+			if tt.wantErr && err == nil {
+				t.Fatal("expected error when a record is unverified under --require-all")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDomainVerifyCmd_WaitTimeoutShowsRecords(t *testing.T) {
+	server := verifyServer(false)
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = verifyCmd.Flags().Set("wait", "false")
+		_ = verifyCmd.Flags().Set("interval", "30s")
+		_ = verifyCmd.Flags().Set("wait-timeout", "10m")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "verify", "domain-id-1", "--wait", "--interval", "10ms", "--wait-timeout", "50ms"})
+	var err error
+	out := captureStdout(t, func() {
+		err = root.Execute()
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if !strings.Contains(out, "DKIM") || !strings.Contains(out, "Return Path CNAME") {
+		t.Errorf("expected the last record table before the timeout:\n%s", out)
+	}
+}
+
+func TestDomainDNSCmd_CopyRecord(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domains/domain-id-1/dns-records" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"spf":         map[string]string{"hostname": "example.com", "type": "TXT", "value": "v=spf1 include:_spf.mailersend.net ~all"},
+				"dkim":        map[string]string{"hostname": "mlsend._domainkey.example.com", "type": "TXT", "value": "k=rsa; p=MIGf"},
+				"return_path": map[string]string{"hostname": "mta.example.com", "type": "CNAME", "value": "mailersend.net"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	var copied []string
+	origCopy := copyToClipboard
+	copyToClipboard = func(text string) error {
+		copied = append(copied, text)
+		return nil
+	}
+	defer func() {
+		copyToClipboard = origCopy
+		_ = dnsCmd.Flags().Set("copy", "")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "dns", "domain-id-1", "--copy", "DKIM"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if len(copied) != 1 || copied[0] != "k=rsa; p=MIGf" {
+		t.Errorf("copied = %q, want the DKIM value", copied)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"domain", "dns", "domain-id-1", "--copy", "mx"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "return-path") {
+		t.Fatalf("expected unknown record error listing valid records, got %v", err)
+	}
+}
+
+func TestWriteClipboard_Headless(t *testing.T) {
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" {
+		t.Skip("the clipboard needs no graphical session on " + runtime.GOOS)
+	}
+	t.Setenv("DISPLAY", "")
+	t.Setenv("WAYLAND_DISPLAY", "")
+
+	err := writeClipboard("value")
+	if !errors.Is(err, errNoGraphicalSession) {
+		t.Fatalf("writeClipboard() error = %v, want errNoGraphicalSession", err)
+	}
+	if !strings.Contains(err.Error(), "no graphical session") {
+		t.Errorf("error %q does not say why", err)
+	}
+}
+
+func TestDomainDNSCmd_ZoneFormat(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	dkim := "k=rsa; p=" + strings.Repeat("A", 300)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"spf":         map[string]string{"hostname": "example.com", "type": "TXT", "value": `v=spf1 include:_spf.mailersend.net "q" ~all`},
+				"dkim":        map[string]string{"hostname": "mlsend._domainkey.example.com", "type": "TXT", "value": dkim},
+				"return_path": map[string]string{"hostname": "mta.example.com", "type": "CNAME", "value": "mailersend.net"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = dnsCmd.Flags().Set("format", "table")
+		dnsCmd.Flags().Lookup("format").Changed = false
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "dns", "domain-id-1", "--format", "zone"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	wantDKIM := "mlsend._domainkey.example.com.\t3600\tIN\tTXT\t\"" + dkim[:255] + "\" \"" + dkim[255:] + "\""
+	for _, want := range []string{
+		"example.com.\t3600\tIN\tTXT\t\"v=spf1 include:_spf.mailersend.net \\\"q\\\" ~all\"",
+		wantDKIM,
+		"mta.example.com.\t3600\tIN\tCNAME\tmailersend.net.",
+	} {
+		if !strings.Contains(out, want+"\n") {
+			t.Errorf("zone output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Custom Tracking") {
+		t.Errorf("empty custom tracking record should be skipped:\n%s", out)
+	}
+
+	_ = dnsCmd.Flags().Set("provider", "route53")
+	defer func() {
+		_ = dnsCmd.Flags().Set("provider", "cloudflare")
+		dnsCmd.Flags().Lookup("provider").Changed = false
+	}()
+	root = newRootCmd()
+	root.SetArgs([]string{"domain", "dns", "domain-id-1", "--format", "terraform"})
+	out = captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	if want := `records = ["` + dkim[:255] + `\"\"` + dkim[255:] + `"]`; !strings.Contains(out, want) {
+		t.Errorf("terraform output missing split DKIM record:\n%s", out)
+	}
+	if !strings.Contains(out, `resource "aws_route53_record" "mailersend_return_path"`) {
+		t.Errorf("terraform output missing return path block:\n%s", out)
+	}
+}
+
+func TestDomainDeleteCmd_JSONShape(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/domains/dom-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"domain", "delete", "dom-1", "--yes"})
+	defer deleteCmd.Flags().Set("yes", "false") //nolint:errcheck
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var got map[string]string
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not a JSON object: %v\n%s", err, out)
+	}
+	want := map[string]string{"status": "deleted", "id": "dom-1", "resource": "domain"}
+	if len(got) != len(want) || got["status"] != want["status"] || got["id"] != want["id"] || got["resource"] != want["resource"] {
+		t.Errorf("output = %v, want %v", got, want)
+	}
+}
+
+func TestDomainVerifyCmd_AllPending(t *testing.T) {
+	var mu sync.Mutex
+	verified := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/domains":
+			if got := r.URL.Query().Get("verified"); got != "false" {
+				t.Errorf("expected verified=false filter, got %q", got)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{
+					{"id": "dom-1", "name": "one.example.com", "is_verified": false},
+					{"id": "dom-2", "name": "two.example.com", "is_verified": false},
+				},
+				"links": map[string]interface{}{},
+				"meta":  map[string]interface{}{},
+			})
+		case strings.HasPrefix(r.URL.Path, "/domains/") && strings.HasSuffix(r.URL.Path, "/verify"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/domains/"), "/verify")
+			mu.Lock()
+			verified[id]++
+			mu.Unlock()
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"message": "ok",
+				"data": map[string]bool{
+					"dkim": true, "spf": true, "mx": true,
+					"tracking": true, "cname": true, "rp_cname": true,
+				},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() {
+		_ = verifyCmd.Flags().Set("all-pending", "false")
+		_ = verifyCmd.Flags().Set("wait", "false")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "verify", "--all-pending", "--wait"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if verified["dom-1"] != 1 || verified["dom-2"] != 1 {
+		t.Errorf("expected each pending domain verified once, got %v", verified)
+	}
+	for _, name := range []string{"one.example.com", "two.example.com"} {
+		if !strings.Contains(out, name) {
+			t.Errorf("summary missing %s:\n%s", name, out)
+		}
+	}
+	if strings.Count(out, "verified") != 2 {
+		t.Errorf("expected both domains summarized as verified:\n%s", out)
+	}
+}
+
+func TestDomainVerifyCmd_AllWaitTimesOut(t *testing.T) {
+	var mu sync.Mutex
+	verified := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/domains":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{
+					{"id": "dom-1", "name": "one.example.com", "is_verified": false},
+					{"id": "dom-2", "name": "two.example.com", "is_verified": false},
+				},
+				"links": map[string]interface{}{},
+				"meta":  map[string]interface{}{},
+			})
+		case strings.HasSuffix(r.URL.Path, "/verify"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/domains/"), "/verify")
+			mu.Lock()
+			verified[id]++
+			mu.Unlock()
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"message": "ok",
+				"data": map[string]bool{
+					"dkim": id == "dom-1", "spf": true, "mx": true,
+					"tracking": true, "cname": true, "rp_cname": true,
+				},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() {
+		_ = verifyCmd.Flags().Set("all", "false")
+		_ = verifyCmd.Flags().Set("wait", "false")
+		_ = verifyCmd.Flags().Set("interval", "30s")
+		_ = verifyCmd.Flags().Set("wait-timeout", "10m")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "verify", "--all", "--wait", "--interval", "10ms", "--wait-timeout", "100ms"})
+	var err error
+	out := captureStdout(t, func() {
+		err = root.Execute()
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Fatalf("expected a non-zero exit for the domain that never verified, got %v", err)
+	}
+	if verified["dom-1"] != 1 || verified["dom-2"] < 2 {
+		t.Errorf("expected dom-1 checked once and dom-2 polled, got %v", verified)
+	}
+	if !strings.Contains(out, "two.example.com") || !strings.Contains(out, "timed out") {
+		t.Errorf("summary should report the timed-out domain:\n%s", out)
+	}
+}
+
+func TestDomainVerifyCmd_AllPendingSharesWaitTimeout(t *testing.T) {
+	var mu sync.Mutex
+	verified := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/domains":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{
+					{"id": "dom-1", "name": "one.example.com", "is_verified": false},
+					{"id": "dom-2", "name": "two.example.com", "is_verified": false},
+					{"id": "dom-3", "name": "three.example.com", "is_verified": false},
+				},
+				"links": map[string]interface{}{},
+				"meta":  map[string]interface{}{},
+			})
+		case strings.HasSuffix(r.URL.Path, "/verify"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/domains/"), "/verify")
+			mu.Lock()
+			verified[id]++
+			mu.Unlock()
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"message": "ok",
+				"data":    map[string]bool{"dkim": false},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() {
+		_ = verifyCmd.Flags().Set("all-pending", "false")
+		_ = verifyCmd.Flags().Set("wait", "false")
+		_ = verifyCmd.Flags().Set("interval", "30s")
+		_ = verifyCmd.Flags().Set("wait-timeout", "10m")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "verify", "--all-pending", "--wait", "--interval", "10ms", "--wait-timeout", "200ms"})
+	start := time.Now()
+	var err error
+	out := captureStdout(t, func() {
+		err = root.Execute()
+	})
+	elapsed := time.Since(start)
+
+	if err == nil || !strings.Contains(err.Error(), "3 of 3") {
+		t.Fatalf("expected all three domains to time out, got %v", err)
+	}
+	if elapsed > 400*time.Millisecond {
+		t.Errorf("expected one shared --wait-timeout, took %s", elapsed)
+	}
+	for _, id := range []string{"dom-1", "dom-2", "dom-3"} {
+		if verified[id] < 2 {
+			t.Errorf("expected %s polled more than once, got %v", id, verified)
+		}
+	}
+	if strings.Count(out, "timed out") != 3 {
+		t.Errorf("summary should report every domain as timed out:\n%s", out)
+	}
+}
+
+func TestDomainVerifyCmd_AllFailsWhenRecordsUnverified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/domains":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{
+					{"id": "dom-1", "name": "one.example.com", "is_verified": false},
+					{"id": "dom-2", "name": "two.example.com", "is_verified": false},
+				},
+				"links": map[string]interface{}{},
+				"meta":  map[string]interface{}{},
+			})
+		case strings.HasSuffix(r.URL.Path, "/verify"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/domains/"), "/verify")
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"message": "ok",
+				"data": map[string]bool{
+					"dkim": id == "dom-1", "spf": true, "mx": true,
+					"tracking": true, "cname": true, "rp_cname": true,
+				},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() { _ = verifyCmd.Flags().Set("all", "false") }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "verify", "--all"})
+	var err error
+	out := captureStdout(t, func() {
+		err = root.Execute()
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 pending domains have unverified DNS records") {
+		t.Fatalf("expected a non-zero exit without --require-all, got %v", err)
+	}
+	if !strings.Contains(out, "unverified: DKIM") {
+		t.Errorf("summary should list the unverified record:\n%s", out)
+	}
+}
+
+func TestDomainListCmd_PageFooter(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": []map[string]interface{}{
+				{"id": "domain-id-11", "name": "eleven.example.com"},
+				{"id": "domain-id-12", "name": "twelve.example.com"},
+			},
+			"links": map[string]interface{}{"next": "https://api.mailersend.com/v1/domains?page=3"},
+			"meta": map[string]interface{}{
+				"current_page": 2,
+				"last_page":    17,
+				"per_page":     "10",
+				"total":        165,
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() {
+		_ = listCmd.Flags().Set("page", "0")
+		_ = listCmd.Flags().Set("per-page", "0")
+		listCmd.Flags().Lookup("page").Changed = false
+		listCmd.Flags().Lookup("per-page").Changed = false
+		output.SetPageFooter("")
+	}()
+
+	origStderr := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stderr = w
+
+	root := newRootCmd()
+	root.SetArgs([]string{"domain", "list", "--page", "2", "--per-page", "10"})
+	captureStdout(t, func() {
+		err = root.Execute()
+	})
+	os.Stderr = origStderr
+	w.Close() //nolint:errcheck
+	if err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	stderr, _ := io.ReadAll(r)
+
+	if query.Get("page") != "2" || query.Get("limit") != "10" {
+		t.Errorf("expected a single request for page 2 of 10, got %v", query)
+	}
+	if !strings.Contains(string(stderr), "Page 2 of 17 (showing 2 of 165)") {
+		t.Errorf("expected a page footer on stderr, got %q", stderr)
+	}
+}
+
+func TestDomainStatsCmd_CountsByStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/domains" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": []map[string]interface{}{
+				{"id": "d1", "name": "a.example.com", "is_verified": true, "is_dns_active": true},
+				{"id": "d2", "name": "b.example.com", "is_verified": true, "is_dns_active": false},
+				{"id": "d3", "name": "c.example.com", "is_verified": false, "is_dns_active": false},
+				{"id": "d4", "name": "d.example.com", "is_verified": false, "is_dns_active": false},
+				{"id": "d5", "name": "e.example.com", "is_verified": true, "is_dns_active": true},
+			},
+			"links": map[string]interface{}{},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"domain", "stats"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var got domainStats
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	want := domainStats{Total: 5, Verified: 3, Unverified: 2, DNSActive: 2, DNSInactive: 3}
+	if got != want {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,453 @@
+package email
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-go"
+	"github.com/spf13/cobra"
+)
+
+// --- email bulk ---
+
+var bulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Send one email per row of a CSV file",
+	Long: `Send one email per row of a CSV file through the bulk email endpoint.
+
+The header row names the columns. "email" is required; "name" and "subject"
+are optional, and every "var_<name>" column becomes the template variable
+<name>, case kept, for that row. Rows without a valid email, or without a subject when
+neither --subject nor --template-id is set, are rejected and not sent.`,
+	Example: `  # recipients.csv:
+  #   email,name,subject,var_firstname
+  #   ann@example.com,Ann,Welcome Ann,Ann
+  mailersend email bulk --file recipients.csv --from hello@yourdomain.com --template-id abc123
+  mailersend email bulk --file recipients.csv --from hello@yourdomain.com --template-id abc123 --dry-run`,
+	RunE: runBulk,
+}
+
+func init() {
+	Cmd.AddCommand(bulkCmd)
+	Cmd.AddCommand(bulkStatusCmd)
+	f := bulkCmd.Flags()
+	f.String("file", "", "path to CSV file with a header row (required)")
+	f.String("from", "", "sender email address (required)")
+	f.String("from-name", "", "sender name")
+	f.String("subject", "", "subject for rows without a subject column value")
+	f.String("template-id", "", "template ID to use")
+	f.String("html", "", "HTML body")
+	f.String("text", "", "plain text body")
+	f.StringSlice("tags", nil, "email tags")
+	f.Bool("dry-run", false, "validate the CSV and print the payloads without sending")
+
+	bulkStatusCmd.Flags().Bool("wait", false, "poll until the bulk email is completed or failed")
+	cmdutil.AddWaitFlags(bulkStatusCmd, 5*time.Second, 30*time.Minute)
+}
+
+// bulkRow is the outcome of parsing one CSV row.
+type bulkRow struct {
+	Row     int    `json:"row"`
+	Email   string `json:"email"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	message *mailersend.Message
+}
+
+// bulkTemplate holds the per-send settings shared by every row.
+type bulkTemplate struct {
+	from       mailersend.From
+	subject    string
+	templateID string
+	html       string
+	text       string
+	tags       []string
+}
+
+// parseBulkCSV reads the CSV and builds one message per row. Rows that fail
+// validation are returned with status "rejected" and no message.
+func parseBulkCSV(r io.Reader, tmpl bulkTemplate) ([]bulkRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	// Column names match case-insensitively, but var_ columns keep the case
+	// of the variable name, which templates are sensitive to.
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	emailCol := -1
+	for i, h := range header {
+		if strings.EqualFold(h, "email") {
+			emailCol = i
+		}
+	}
+	if emailCol < 0 {
+		return nil, fmt.Errorf("CSV header must include an \"email\" column")
+	}
+
+	var rows []bulkRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		rows = append(rows, buildBulkRow(line, header, record, tmpl))
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("CSV file has no rows after the header")
+	}
+	return rows, nil
+}
+
+func buildBulkRow(line int, header, record []string, tmpl bulkTemplate) bulkRow {
+	row := bulkRow{Row: line, Status: "accepted"}
+	var name, subject string
+	vars := map[string]interface{}{}
+	for i, h := range header {
+		if i >= len(record) {
+			break
+		}
+		value := strings.TrimSpace(record[i])
+		switch lower := strings.ToLower(h); {
+		case lower == "email":
+			row.Email = value
+		case lower == "name":
+			name = value
+		case lower == "subject":
+			subject = value
+		case strings.HasPrefix(lower, "var_") && len(h) > len("var_"):
+			vars[h[len("var_"):]] = value
+		}
+	}
+
+	reject := func(reason string) bulkRow {
+		row.Status = "rejected"
+		row.Reason = reason
+		return row
+	}
+	if row.Email == "" {
+		return reject("missing email")
+	}
+	if addr, err := mail.ParseAddress(row.Email); err != nil || addr.Address != row.Email {
+		return reject("invalid email")
+	}
+	if subject == "" {
+		subject = tmpl.subject
+	}
+	if subject == "" && tmpl.templateID == "" {
+		return reject("missing subject")
+	}
+
+	m := &mailersend.Message{
+		From:       tmpl.from,
+		Recipients: []mailersend.Recipient{{Email: row.Email, Name: name}},
+		Subject:    subject,
+		HTML:       tmpl.html,
+		Text:       tmpl.text,
+		TemplateID: tmpl.templateID,
+		Tags:       tmpl.tags,
+	}
+	if len(vars) > 0 {
+		m.Personalization = []mailersend.Personalization{{Email: row.Email, Data: vars}}
+	}
+	row.message = m
+	return row
+}
+
+func runBulk(cobraCmd *cobra.Command, args []string) error {
+	flags := cobraCmd.Flags()
+	filePath, _ := flags.GetString("file")
+	from, _ := flags.GetString("from")
+	fromName, _ := flags.GetString("from-name")
+	subject, _ := flags.GetString("subject")
+	templateID, _ := flags.GetString("template-id")
+	html, _ := flags.GetString("html")
+	text, _ := flags.GetString("text")
+	tags, _ := flags.GetStringSlice("tags")
+	dryRun, _ := flags.GetBool("dry-run")
+
+	filePath, err := prompt.RequireArg(filePath, "file", "Path to CSV file")
+	if err != nil {
+		return err
+	}
+	from, err = prompt.RequireArg(from, "from", "Sender email address")
+	if err != nil {
+		return err
+	}
+	if templateID == "" && html == "" && text == "" {
+		return errors.New("one of --template-id, --html, or --text is required")
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read CSV file: %w", err)
+	}
+	defer f.Close() //nolint:errcheck
+
+	rows, err := parseBulkCSV(f, bulkTemplate{
+		from:       mailersend.From{Email: from, Name: fromName},
+		subject:    subject,
+		templateID: templateID,
+		html:       html,
+		text:       text,
+		tags:       tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	var messages []*mailersend.Message
+	var rejected []bulkRow
+	for _, r := range rows {
+		if r.message != nil {
+			messages = append(messages, r.message)
+		} else {
+			rejected = append(rejected, r)
+		}
+	}
+
+	if dryRun {
+		for _, r := range rejected {
+			output.Note(fmt.Sprintf("row %d (%s): %s", r.Row, r.Email, r.Reason))
+		}
+		return output.JSON(messages)
+	}
+
+	if len(messages) == 0 {
+		return fmt.Errorf("all %d rows were rejected; nothing to send", len(rows))
+	}
+
+	ms, err := cmdutil.NewSDKClient(cobraCmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	result, _, err := ms.BulkEmail.Send(ctx, messages)
+	if err != nil {
+		return sdkclient.WrapError(err)
+	}
+
+	if cmdutil.JSONFlag(cobraCmd) {
+		if rejected == nil {
+			rejected = []bulkRow{}
+		}
+		return output.JSON(map[string]interface{}{
+			"bulk_email_id": result.BulkEmailID,
+			"accepted":      len(messages),
+			"rejected":      rejected,
+		})
+	}
+
+	headers := []string{"ROW", "EMAIL", "STATUS", "REASON"}
+	var tableRows [][]string
+	for _, r := range rows {
+		tableRows = append(tableRows, []string{strconv.Itoa(r.Row), r.Email, r.Status, r.Reason})
+	}
+	output.Render(headers, tableRows)
+
+	output.Success(fmt.Sprintf("Bulk email queued: %d accepted, %d rejected. ID: %s", len(messages), len(rejected), result.BulkEmailID))
+	return nil
+}
+
+// --- email bulk-status ---
+
+var bulkStatusCmd = &cobra.Command{
+	Use:   "bulk-status <bulk_email_id>",
+	Short: "Get the status of a bulk email",
+	Long: `Get the state, recipient counts, and validation errors of a bulk email.
+
+Messages that failed validation or had suppressed recipients are listed in
+a second table by message index, with the failing field and the error or
+suppression reason. With --json the raw API response is printed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBulkStatus,
+}
+
+func runBulkStatus(cobraCmd *cobra.Command, args []string) error {
+	ms, err := cmdutil.NewSDKClient(cobraCmd)
+	if err != nil {
+		return err
+	}
+
+	id := args[0]
+	wait, _ := cobraCmd.Flags().GetBool("wait")
+	ctx := context.Background()
+
+	var raw json.RawMessage
+	var status mailersend.BulkEmailRoot
+	check := func(ctx context.Context) (bool, error) {
+		if err := sdkclient.GetJSON(ctx, ms, "/bulk-email/"+id, &raw); err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal(raw, &status); err != nil {
+			return false, fmt.Errorf("failed to parse response: %w", err)
+		}
+		state := status.Data.State
+		if state == "completed" || state == "failed" {
+			return true, nil
+		}
+		if wait {
+			output.Note(fmt.Sprintf("Waiting... (state: %s)", state))
+		}
+		return false, nil
+	}
+
+	if wait {
+		// Stop polling cleanly on Ctrl-C instead of leaving it to the
+		// default signal handler.
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+
+		interval, timeout := cmdutil.WaitFlags(cobraCmd)
+		err = cmdutil.Poll(ctx, interval, timeout, check)
+		if errors.Is(err, context.Canceled) {
+			return fmt.Errorf("interrupted while waiting for bulk email %s; it continues on the server", id)
+		}
+	} else {
+		_, err = check(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cmdutil.JSONFlag(cobraCmd) {
+		return output.JSON(raw)
+	}
+
+	d := status.Data
+	headers := []string{"FIELD", "VALUE"}
+	rows := [][]string{
+		{"ID", d.ID},
+		{"State", d.State},
+		{"Total Recipients", strconv.Itoa(d.TotalRecipientsCount)},
+		{"Suppressed Recipients", strconv.Itoa(d.SuppressedRecipientsCount)},
+		{"Validation Errors", strconv.Itoa(d.ValidationErrorsCount)},
+		{"Messages", strings.Join(d.MessagesID, ", ")},
+		{"Created At", output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")},
+		{"Updated At", output.FormatTime(d.UpdatedAt, "2006-01-02 15:04:05")},
+	}
+	output.Render(headers, rows)
+
+	failures := append(validationErrorRows(d.ValidationErrors), suppressedRows(d.SuppressedRecipients)...)
+	if len(failures) > 0 {
+		sortFailures(failures)
+		fmt.Println()
+		output.Render([]string{"MESSAGE", "STATUS", "FIELD", "DETAILS"}, failures)
+	}
+	return nil
+}
+
+// splitMessageKey splits an API key such as "message.1.to.0.email" into
+// the message index ("1") and the rest of the path ("to.0.email").
+func splitMessageKey(key string) (index, field string) {
+	rest, ok := strings.CutPrefix(key, "message.")
+	if !ok {
+		return "", key
+	}
+	index, field, _ = strings.Cut(rest, ".")
+	return index, field
+}
+
+// sortFailures orders MESSAGE/STATUS/FIELD/DETAILS rows by message index,
+// numerically, then by field and details.
+func sortFailures(rows [][]string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, errA := strconv.Atoi(rows[i][0])
+		b, errB := strconv.Atoi(rows[j][0])
+		if errA == nil && errB == nil && a != b {
+			return a < b
+		}
+		if rows[i][0] != rows[j][0] {
+			return rows[i][0] < rows[j][0]
+		}
+		if rows[i][2] != rows[j][2] {
+			return rows[i][2] < rows[j][2]
+		}
+		return rows[i][3] < rows[j][3]
+	})
+}
+
+// validationErrorRows lists validation errors, which the API keys by field
+// path (e.g. "message.1.to.0.email"), as MESSAGE/STATUS/FIELD/DETAILS rows.
+func validationErrorRows(v interface{}) [][]string {
+	errs, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var rows [][]string
+	for k, e := range errs {
+		var msgs []string
+		if list, ok := e.([]interface{}); ok {
+			for _, m := range list {
+				msgs = append(msgs, fmt.Sprint(m))
+			}
+		} else {
+			msgs = append(msgs, fmt.Sprint(e))
+		}
+		index, field := splitMessageKey(k)
+		rows = append(rows, []string{index, "invalid", field, strings.Join(msgs, "; ")})
+	}
+	return rows
+}
+
+// suppressedRows lists suppressed recipients, which the API reports as
+// {"message.1": {"to": {"<email>": {"reasons": [...]}}}}, as
+// MESSAGE/STATUS/FIELD/DETAILS rows.
+func suppressedRows(v interface{}) [][]string {
+	messages, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var rows [][]string
+	for k, fields := range messages {
+		index, _ := splitMessageKey(k)
+		byField, _ := fields.(map[string]interface{})
+		for field, recipients := range byField {
+			byEmail, _ := recipients.(map[string]interface{})
+			for email, detail := range byEmail {
+				var reasons []string
+				if d, ok := detail.(map[string]interface{}); ok {
+					if list, ok := d["reasons"].([]interface{}); ok {
+						for _, r := range list {
+							reasons = append(reasons, fmt.Sprint(r))
+						}
+					}
+				}
+				details := email
+				if len(reasons) > 0 {
+					details += ": " + strings.Join(reasons, ", ")
+				}
+				rows = append(rows, []string{index, "suppressed", field, details})
+			}
+		}
+	}
+	return rows
+}
@@ -0,0 +1,284 @@
+package email
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-go"
+)
+
+func TestParseBulkCSV(t *testing.T) {
+	csvData := `email,name,subject,var_firstname
+ann@example.com,Ann,Welcome Ann,Ann
+not-an-email,Bob,,Bob
+carol@example.com,Carol,,Carol
+`
+	rows, err := parseBulkCSV(strings.NewReader(csvData), bulkTemplate{
+		from:    mailersend.From{Email: "hello@example.com"},
+		subject: "Default subject",
+	})
+	if err != nil {
+		t.Fatalf("parseBulkCSV error: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	ann := rows[0]
+	if ann.Status != "accepted" || ann.message == nil {
+		t.Fatalf("row 2 = %+v, want accepted", ann)
+	}
+	if ann.message.Subject != "Welcome Ann" || ann.message.Recipients[0].Name != "Ann" {
+		t.Errorf("unexpected message for row 2: %+v", ann.message)
+	}
+	if got := ann.message.Personalization[0].Data["firstname"]; got != "Ann" {
+		t.Errorf("firstname = %v, want Ann", got)
+	}
+
+	if rows[1].Status != "rejected" || rows[1].Reason != "invalid email" || rows[1].Row != 3 {
+		t.Errorf("row 3 = %+v, want rejected for invalid email", rows[1])
+	}
+	if rows[2].message == nil || rows[2].message.Subject != "Default subject" {
+		t.Errorf("row 4 should fall back to --subject, got %+v", rows[2])
+	}
+}
+
+func TestParseBulkCSV_KeepsVariableNameCase(t *testing.T) {
+	csvData := "Email,Name,VAR_firstName,var_Plan\nann@example.com,Ann,Ann,Pro\n"
+	rows, err := parseBulkCSV(strings.NewReader(csvData), bulkTemplate{subject: "Hi"})
+	if err != nil {
+		t.Fatalf("parseBulkCSV error: %v", err)
+	}
+	if rows[0].message == nil {
+		t.Fatalf("row 2 = %+v, want accepted", rows[0])
+	}
+	want := map[string]interface{}{"firstName": "Ann", "Plan": "Pro"}
+	if got := rows[0].message.Personalization[0].Data; !reflect.DeepEqual(got, want) {
+		t.Errorf("personalization = %v, want %v", got, want)
+	}
+}
+
+func TestParseBulkCSV_MissingEmailColumn(t *testing.T) {
+	_, err := parseBulkCSV(strings.NewReader("name\nAnn\n"), bulkTemplate{})
+	if err == nil || !strings.Contains(err.Error(), `"email" column`) {
+		t.Fatalf("expected missing email column error, got %v", err)
+	}
+}
+
+func TestBulkCmd_SendsAcceptedRows(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer func() {
+		for _, name := range []string{"file", "from", "template-id", "dry-run"} {
+			_ = bulkCmd.Flags().Set(name, bulkCmd.Flags().Lookup(name).DefValue)
+		}
+	}()
+
+	csvPath := filepath.Join(t.TempDir(), "recipients.csv")
+	csvData := "email,var_firstname\nann@example.com,Ann\n,Nobody\nbob@example.com,Bob\n"
+	if err := os.WriteFile(csvPath, []byte(csvData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var received []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/bulk-email" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"message":"The bulk email is being processed.","bulk_email_id":"bulk-123"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"email", "bulk", "--file", csvPath, "--from", "hello@example.com", "--template-id", "tmpl-1"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if len(received) != 2 {
+		t.Fatalf("expected 2 messages sent, got %d", len(received))
+	}
+	if !strings.Contains(out, "missing email") || !strings.Contains(out, "bulk-123") {
+		t.Errorf("expected summary with rejected row and bulk ID, got:\n%s", out)
+	}
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestBulkStatusCmd_WaitUntilCompleted(t *testing.T) {
+	defer func() {
+		_ = bulkStatusCmd.Flags().Set("wait", "false")
+		_ = bulkStatusCmd.Flags().Set("interval", "5s")
+	}()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bulk-email/bulk-123" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		calls++
+		state := "processing"
+		if calls == 3 {
+			state = "completed"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"id":                          "bulk-123",
+				"state":                       state,
+				"total_recipients_count":      2,
+				"suppressed_recipients_count": 0,
+				"validation_errors_count":     1,
+				"validation_errors": map[string]interface{}{
+					"message.1.to.0.email": []string{"The email must be a valid email address."},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"email", "bulk-status", "bulk-123", "--wait", "--interval", "1ms"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if calls != 3 {
+		t.Errorf("expected 3 fetches, got %d", calls)
+	}
+	for _, want := range []string{"completed", "to.0.email", "must be a valid email"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestBulkStatusCmd_WaitTimesOut(t *testing.T) {
+	defer func() {
+		_ = bulkStatusCmd.Flags().Set("wait", "false")
+		_ = bulkStatusCmd.Flags().Set("interval", "5s")
+		_ = bulkStatusCmd.Flags().Set("wait-timeout", "30m")
+	}()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{"id": "bulk-123", "state": "processing"},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"email", "bulk-status", "bulk-123", "--wait", "--interval", "10ms", "--wait-timeout", "50ms"})
+	var err error
+	captureStdout(t, func() {
+		err = root.Execute()
+	})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestBulkStatusCmd_PerMessageFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"id":                          "bulk-123",
+				"state":                       "completed",
+				"total_recipients_count":      5,
+				"suppressed_recipients_count": 1,
+				"validation_errors_count":     2,
+				"validation_errors": map[string]interface{}{
+					"message.10.subject":   []string{"The subject field is required."},
+					"message.2.to.0.email": []string{"The email must be a valid email address."},
+				},
+				"suppressed_recipients": map[string]interface{}{
+					"message.3": map[string]interface{}{
+						"to": map[string]interface{}{
+							"gone@example.com": map[string]interface{}{"reasons": []string{"hard_bounced"}},
+						},
+					},
+				},
+				"messages_id": []string{"msg-0", "msg-1"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"email", "bulk-status", "bulk-123"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	lines := strings.Split(out, "\n")
+	var failures []string
+	for _, line := range lines {
+		if strings.Contains(line, "invalid") || strings.Contains(line, "suppressed") {
+			failures = append(failures, line)
+		}
+	}
+	if len(failures) != 3 {
+		t.Fatalf("expected 3 failure rows, got %d:\n%s", len(failures), out)
+	}
+	for i, want := range [][]string{
+		{"2", "invalid", "to.0.email", "must be a valid email"},
+		{"3", "suppressed", "to", "gone@example.com: hard_bounced"},
+		{"10", "invalid", "subject", "subject field is required"},
+	} {
+		for _, w := range want {
+			if !strings.Contains(failures[i], w) {
+				t.Errorf("failure row %d = %q, want it to contain %q", i, failures[i], w)
+			}
+		}
+	}
+}
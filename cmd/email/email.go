@@ -1,10 +1,15 @@
 package email
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
+	"net/mail"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
@@ -12,6 +17,7 @@ import (
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
 	"github.com/mailersend/mailersend-go"
 	"github.com/spf13/cobra"
+	"github.com/yuin/goldmark"
 )
 
 var Cmd = &cobra.Command{
@@ -33,30 +39,242 @@ func init() {
 	f.String("from-name", "", "sender name")
 	f.String("to", "", "recipient email address (required)")
 	f.String("to-name", "", "recipient name")
-	f.String("cc", "", "CC email address")
-	f.String("bcc", "", "BCC email address")
+	f.String("cc", "", "CC email address(es), comma-separated")
+	f.String("bcc", "", "BCC email address(es), comma-separated")
+	f.String("to-file", "", "file of recipient addresses, one per line (\"Name <email>\" allowed)")
+	f.String("cc-file", "", "file of CC addresses, one per line")
+	f.String("bcc-file", "", "file of BCC addresses, one per line")
+	f.Bool("no-self-cc", false, "drop the from address from CC and BCC")
 	f.String("reply-to", "", "reply-to email address")
 	f.String("subject", "", "email subject")
 	f.String("text", "", "plain text body")
 	f.String("html", "", "HTML body")
 	f.String("html-file", "", "path to file containing HTML body")
 	f.String("text-file", "", "path to file containing plain text body")
+	f.String("html-from-markdown", "", "path to a Markdown file to render as the HTML body")
+	f.String("stdin-format", "html", "body field for content piped on stdin: html or text (not detected from the content)")
 	f.String("template-id", "", "template ID to use")
 	f.StringSlice("tags", nil, "email tags")
 	f.Int64("send-at", 0, "unix timestamp for scheduled sending")
 	f.Bool("track-clicks", false, "enable click tracking")
 	f.Bool("track-opens", false, "enable open tracking")
 	f.Bool("track-content", false, "enable content tracking")
+	f.String("priority", "", "message priority: high, normal, or low (sets X-Priority and Importance headers)")
+	f.StringArray("header", nil, "custom header as \"Name: Value\" (repeatable)")
+	f.StringArray("attach", nil, "path of a file to attach (repeatable)")
+	f.StringArray("attach-inline", nil, "inline attachment as path:cid, referenced in HTML as cid:<cid> (repeatable)")
+	f.Bool("dry-run", false, "print the request body as JSON without sending")
 }
 
-func runSend(cobraCmd *cobra.Command, args []string) error {
-	ms, err := cmdutil.NewSDKClient(cobraCmd)
+// maxAttachmentBytes is the API's limit on the combined size of an email's
+// attachments.
+const maxAttachmentBytes = 25 * 1024 * 1024
+
+// readAttachment reads a file and returns it as a base64-encoded attachment
+// named after the file.
+func readAttachment(path, disposition, id string) (mailersend.Attachment, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return err
+		return mailersend.Attachment{}, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	return mailersend.Attachment{
+		Content:     base64.StdEncoding.EncodeToString(data),
+		Filename:    filepath.Base(path),
+		Disposition: disposition,
+		ID:          id,
+	}, nil
+}
+
+// buildAttachments reads the --attach and --attach-inline files. Inline
+// values take the form path:cid; the content ID is split off at the last
+// colon so that paths containing colons still work.
+func buildAttachments(attach, inline []string) ([]mailersend.Attachment, error) {
+	var attachments []mailersend.Attachment
+	for _, path := range attach {
+		a, err := readAttachment(path, mailersend.DispositionAttachment, "")
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+	for _, v := range inline {
+		i := strings.LastIndex(v, ":")
+		if i <= 0 || i == len(v)-1 {
+			return nil, fmt.Errorf("invalid --attach-inline %q: use path:cid", v)
+		}
+		a, err := readAttachment(v[:i], mailersend.DispositionInline, v[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+	return attachments, nil
+}
+
+// renderMarkdownFile reads a Markdown file and renders it to HTML. goldmark's
+// defaults leave raw HTML and javascript: style link URLs out of the output.
+func renderMarkdownFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Markdown file: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := goldmark.Convert(data, &buf); err != nil {
+		return "", fmt.Errorf("failed to render Markdown file: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// readRecipientFile reads a --to-file, --cc-file, or --bcc-file: one address
+// per line, either bare or as "Name <email>". Blank lines and lines starting
+// with # are skipped. Names are recorded in names, keyed by lowercase address.
+func readRecipientFile(path string, names map[string]string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recipients file: %w", err)
+	}
+	var emails []string
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addr, err := mail.ParseAddress(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid address %q", path, i+1, line)
+		}
+		if addr.Name != "" {
+			names[strings.ToLower(addr.Address)] = addr.Name
+		}
+		emails = append(emails, addr.Address)
+	}
+	return emails, nil
+}
+
+// dedupeRecipients splits the comma-separated --cc and --bcc values and drops
+// addresses that already appear in a higher-priority placement (to, then cc,
+// then bcc), comparing case-insensitively. to may hold several comma-separated
+// addresses. With noSelfCC the from address is dropped from cc and bcc as well.
+func dedupeRecipients(to, cc, bcc, from string, noSelfCC bool) ([]string, []string) {
+	seen := map[string]bool{}
+	for _, addr := range strings.Split(to, ",") {
+		seen[strings.ToLower(strings.TrimSpace(addr))] = true
+	}
+	if noSelfCC && from != "" {
+		seen[strings.ToLower(from)] = true
+	}
+	keep := func(list string) []string {
+		var out []string
+		for _, addr := range strings.Split(list, ",") {
+			addr = strings.TrimSpace(addr)
+			key := strings.ToLower(addr)
+			if addr == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			out = append(out, addr)
+		}
+		return out
+	}
+	ccList := keep(cc)
+	bccList := keep(bcc)
+	return ccList, bccList
+}
+
+// toRecipients converts addresses to SDK recipients, taking display names
+// from names (keyed by lowercase address) where known.
+func toRecipients(emails []string, names map[string]string) []mailersend.Recipient {
+	recipients := make([]mailersend.Recipient, 0, len(emails))
+	for _, e := range emails {
+		recipients = append(recipients, mailersend.Recipient{Email: e, Name: names[strings.ToLower(e)]})
 	}
+	return recipients
+}
+
+// uniqueEmails drops repeated addresses, comparing case-insensitively.
+func uniqueEmails(emails []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, e := range emails {
+		key := strings.ToLower(e)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, e)
+	}
+	return out
+}
 
+// repeatableHeaders are the headers that may appear more than once in a
+// message (RFC 5322 section 3.6). Any other header given twice is an error.
+var repeatableHeaders = map[string]bool{
+	"comments": true,
+	"keywords": true,
+	"received": true,
+}
+
+// parseHeaders parses --header values of the form "Name: Value". Names must
+// be RFC 5322 field names (printable ASCII without spaces or colons), and a
+// header may only be given once unless it is repeatable.
+func parseHeaders(values []string) ([]mailersend.Header, error) {
+	var headers []mailersend.Header
+	seen := map[string]bool{}
+	for _, v := range values {
+		name, value, ok := strings.Cut(v, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --header %q: use \"Name: Value\"", v)
+		}
+		for _, r := range name {
+			if r < '!' || r > '~' {
+				return nil, fmt.Errorf("invalid --header name %q: only printable ASCII without spaces or colons is allowed", name)
+			}
+		}
+		key := strings.ToLower(name)
+		if seen[key] && !repeatableHeaders[key] {
+			return nil, fmt.Errorf("duplicate --header %q", name)
+		}
+		seen[key] = true
+		headers = append(headers, mailersend.Header{Name: name, Value: strings.TrimSpace(value)})
+	}
+	return headers, nil
+}
+
+// priorityHeaders returns the X-Priority and Importance headers for the given
+// --priority value.
+func priorityHeaders(priority string) ([]mailersend.Header, error) {
+	var xPriority string
+	switch priority {
+	case "high":
+		xPriority = "1 (Highest)"
+	case "normal":
+		xPriority = "3 (Normal)"
+	case "low":
+		xPriority = "5 (Lowest)"
+	default:
+		return nil, fmt.Errorf("invalid --priority %q: use high, normal, or low", priority)
+	}
+	return []mailersend.Header{
+		{Name: "X-Priority", Value: xPriority},
+		{Name: "Importance", Value: priority},
+	}, nil
+}
+
+func runSend(cobraCmd *cobra.Command, args []string) error {
 	flags := cobraCmd.Flags()
 
+	// A dry run makes no API call, so it needs no token.
+	dryRun, _ := flags.GetBool("dry-run")
+	var ms *mailersend.Mailersend
+	var err error
+	if !dryRun {
+		ms, err = cmdutil.NewSDKClient(cobraCmd)
+		if err != nil {
+			return err
+		}
+	}
+
 	from, _ := flags.GetString("from")
 	fromName, _ := flags.GetString("from-name")
 	to, _ := flags.GetString("to")
@@ -69,18 +287,99 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 	html, _ := flags.GetString("html")
 	htmlFile, _ := flags.GetString("html-file")
 	textFile, _ := flags.GetString("text-file")
+	markdownFile, _ := flags.GetString("html-from-markdown")
 	templateID, _ := flags.GetString("template-id")
 	tags, _ := flags.GetStringSlice("tags")
 	sendAt, _ := flags.GetInt64("send-at")
 	trackClicks, _ := flags.GetBool("track-clicks")
 	trackOpens, _ := flags.GetBool("track-opens")
 	trackContent, _ := flags.GetBool("track-content")
+	priority, _ := flags.GetString("priority")
+	noSelfCC, _ := flags.GetBool("no-self-cc")
+	attach, _ := flags.GetStringArray("attach")
+	headerValues, _ := flags.GetStringArray("header")
+	toFile, _ := flags.GetString("to-file")
+	ccFile, _ := flags.GetString("cc-file")
+	bccFile, _ := flags.GetString("bcc-file")
+	attachInline, _ := flags.GetStringArray("attach-inline")
+	stdinFormat, _ := flags.GetString("stdin-format")
+
+	if stdinFormat != "html" && stdinFormat != "text" {
+		return fmt.Errorf("invalid --stdin-format %q: use html or text", stdinFormat)
+	}
+	if markdownFile != "" && (html != "" || htmlFile != "") {
+		return fmt.Errorf("--html-from-markdown cannot be combined with --html or --html-file")
+	}
 
-	// Interactive prompts for required fields
-	to, err = prompt.RequireArg(to, "to", "Recipient email address")
+	attachments, err := buildAttachments(attach, attachInline)
 	if err != nil {
 		return err
 	}
+	total := 0
+	for _, a := range attachments {
+		total += len(a.Content)
+	}
+	if total > maxAttachmentBytes {
+		fmt.Fprintf(os.Stderr, "Warning: attachments total %.1f MB encoded, over the API's 25 MB limit; the send will likely be rejected\n", float64(total)/(1024*1024))
+	}
+
+	headers, err := parseHeaders(headerValues)
+	if err != nil {
+		return err
+	}
+	if priority != "" {
+		priorityHdrs, err := priorityHeaders(priority)
+		if err != nil {
+			return err
+		}
+		for _, h := range headers {
+			if strings.EqualFold(h.Name, "X-Priority") || strings.EqualFold(h.Name, "Importance") {
+				return fmt.Errorf("--header %s cannot be combined with --priority", h.Name)
+			}
+		}
+		headers = append(headers, priorityHdrs...)
+	}
+
+	// Recipients from files are combined with the inline flags
+	names := map[string]string{}
+	var toList []string
+	if toFile != "" {
+		toList, err = readRecipientFile(toFile, names)
+		if err != nil {
+			return err
+		}
+		if to == "" && len(toList) == 0 {
+			return fmt.Errorf("no recipients found in %s", toFile)
+		}
+	}
+	for _, f := range []struct {
+		list *string
+		path string
+	}{{&cc, ccFile}, {&bcc, bccFile}} {
+		if f.path == "" {
+			continue
+		}
+		emails, err := readRecipientFile(f.path, names)
+		if err != nil {
+			return err
+		}
+		*f.list = strings.Join(append([]string{*f.list}, emails...), ",")
+	}
+
+	// Interactive prompts for required fields
+	if toFile == "" {
+		to, err = prompt.RequireArg(to, "to", "Recipient email address")
+		if err != nil {
+			return err
+		}
+	}
+	if to != "" {
+		if toName != "" {
+			names[strings.ToLower(to)] = toName
+		}
+		toList = append([]string{to}, toList...)
+	}
+	toList = uniqueEmails(toList)
 
 	if from == "" && prompt.IsInteractive() {
 		from, err = prompt.Input("Sender email address", "")
@@ -97,7 +396,7 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 	}
 
 	// Interactive prompt for body/template when none provided
-	if html == "" && text == "" && htmlFile == "" && textFile == "" && templateID == "" && prompt.IsInteractive() {
+	if html == "" && text == "" && htmlFile == "" && textFile == "" && markdownFile == "" && templateID == "" && prompt.IsInteractive() {
 		contentType, err := prompt.Select("Email content type", []string{"text", "html", "template-id"})
 		if err != nil {
 			return err
@@ -130,6 +429,14 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 		html = string(data)
 	}
 
+	// Render HTML from Markdown if --html-from-markdown is set
+	if markdownFile != "" {
+		html, err = renderMarkdownFile(markdownFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Read text from file if --text-file is set
 	if textFile != "" {
 		data, err := os.ReadFile(textFile)
@@ -139,19 +446,24 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 		text = string(data)
 	}
 
-	// Read stdin as HTML body if no body or template provided and stdin is piped
+	// Read stdin as the body if no body or template provided and stdin is
+	// piped. --stdin-format picks the field; the content is not inspected.
 	if html == "" && text == "" && templateID == "" && !prompt.IsInteractive() {
 		data, err := io.ReadAll(os.Stdin)
 		if err != nil {
 			return fmt.Errorf("failed to read stdin: %w", err)
 		}
 		if len(data) > 0 {
-			html = string(data)
+			if stdinFormat == "text" {
+				text = string(data)
+			} else {
+				html = string(data)
+			}
 		}
 	}
 
 	// Build message using SDK
-	message := ms.Email.NewMessage()
+	message := new(mailersend.Message)
 
 	// From
 	if from != "" {
@@ -162,20 +474,15 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 	}
 
 	// To
-	recipient := mailersend.Recipient{
-		Email: to,
-		Name:  toName,
-	}
-	message.SetRecipients([]mailersend.Recipient{recipient})
+	message.SetRecipients(toRecipients(toList, names))
 
-	// CC
-	if cc != "" {
-		message.SetCc([]mailersend.Recipient{{Email: cc}})
+	// CC and BCC, minus addresses already receiving the email
+	ccList, bccList := dedupeRecipients(strings.Join(toList, ","), cc, bcc, from, noSelfCC)
+	if len(ccList) > 0 {
+		message.SetCc(toRecipients(ccList, names))
 	}
-
-	// BCC
-	if bcc != "" {
-		message.SetBcc([]mailersend.Recipient{{Email: bcc}})
+	if len(bccList) > 0 {
+		message.SetBcc(toRecipients(bccList, names))
 	}
 
 	// Reply-To
@@ -208,6 +515,16 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 		message.SetTags(tags)
 	}
 
+	// Attachments
+	for _, a := range attachments {
+		message.AddAttachment(a)
+	}
+
+	// Headers
+	if len(headers) > 0 {
+		message.SetHeaders(headers)
+	}
+
 	// Send at
 	if sendAt != 0 {
 		message.SetSendAt(sendAt)
@@ -222,6 +539,11 @@ func runSend(cobraCmd *cobra.Command, args []string) error {
 		})
 	}
 
+	// Print the request body as the SDK would serialize it
+	if dryRun {
+		return output.JSON(message)
+	}
+
 	// Send the email
 	ctx := context.Background()
 	resp, err := ms.Email.Send(ctx, message)
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -24,11 +25,9 @@ func newRootCmd() *cobra.Command {
 
 	// Reset sendCmd flags to avoid state leaking between tests.
 	sendCmd.Flags().VisitAll(func(f *pflag.Flag) {
-		if f.Value.Type() == "stringSlice" {
-			// StringSlice.Set appends, so we need to use the SliceValue interface.
-			if sv, ok := f.Value.(pflag.SliceValue); ok {
-				_ = sv.Replace(nil)
-			}
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			// Slice and array Set appends, so we need to use the SliceValue interface.
+			_ = sv.Replace(nil)
 		} else {
 			_ = f.Value.Set(f.DefValue)
 		}
@@ -45,10 +44,11 @@ func TestSendCmd_FlagsRegistered(t *testing.T) {
 		"from", "from-name", "to", "to-name",
 		"cc", "bcc", "reply-to",
 		"subject", "text", "html",
-		"html-file", "text-file",
+		"html-file", "text-file", "html-from-markdown",
 		"template-id", "tags",
 		"send-at",
 		"track-clicks", "track-opens", "track-content",
+		"priority", "no-self-cc",
 	}
 
 	for _, name := range expected {
@@ -265,6 +265,114 @@ func TestSendCmd_HTMLFile(t *testing.T) {
 	}
 }
 
+func TestSendCmd_StdinFormatText(t *testing.T) {
+	var receivedBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedBody)
+		w.Header().Set("x-message-id", "msg-stdin-123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	// A pipe on stdin makes the command non-interactive and reads the body
+	// from it.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close() //nolint:errcheck
+	if _, err := w.Write([]byte("Plain text from a pipe")); err != nil {
+		t.Fatal(err)
+	}
+	w.Close() //nolint:errcheck
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "sender@example.com",
+		"--to", "test@example.com",
+		"--subject", "Stdin test",
+		"--stdin-format", "text",
+	})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if receivedBody["text"] != "Plain text from a pipe" {
+		t.Errorf("expected text body from stdin, got %q", receivedBody["text"])
+	}
+	if _, ok := receivedBody["html"]; ok {
+		t.Errorf("expected no html body, got %q", receivedBody["html"])
+	}
+}
+
+func TestSendCmd_HTMLFromMarkdown(t *testing.T) {
+	dir := t.TempDir()
+	mdPath := filepath.Join(dir, "email.md")
+	if err := os.WriteFile(mdPath, []byte("# H1\n\nHello **world**.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var receivedBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedBody)
+		w.Header().Set("x-message-id", "msg-md-123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "sender@example.com",
+		"--to", "test@example.com",
+		"--subject", "Markdown test",
+		"--html-from-markdown", mdPath,
+	})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	html, _ := receivedBody["html"].(string)
+	if !strings.Contains(html, "<h1>H1</h1>") {
+		t.Errorf("expected rendered <h1> in html body, got %q", html)
+	}
+	if !strings.Contains(html, "<strong>world</strong>") {
+		t.Errorf("expected rendered <strong> in html body, got %q", html)
+	}
+}
+
+func TestRenderMarkdownFile_DropsUnsafeContent(t *testing.T) {
+	mdPath := filepath.Join(t.TempDir(), "email.md")
+	md := "[click](javascript:alert(1)) ![img](javascript:alert(2))\n\n<script>alert(3)</script>\n"
+	if err := os.WriteFile(mdPath, []byte(md), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	html, err := renderMarkdownFile(mdPath)
+	if err != nil {
+		t.Fatalf("renderMarkdownFile() error: %v", err)
+	}
+	if strings.Contains(html, "javascript:") || strings.Contains(html, "<script>") {
+		t.Errorf("expected unsafe URLs and raw HTML to be dropped, got %q", html)
+	}
+}
+
 func TestSendCmd_JSONOutput(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("x-message-id", "msg-json-123")
@@ -294,6 +402,169 @@ func TestSendCmd_JSONOutput(t *testing.T) {
 	}
 }
 
+func TestSendCmd_PriorityHeaders(t *testing.T) {
+	tests := []struct {
+		priority   string
+		xPriority  string
+		importance string
+	}{
+		{"high", "1 (Highest)", "high"},
+		{"normal", "3 (Normal)", "normal"},
+		{"low", "5 (Lowest)", "low"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.priority, func(t *testing.T) {
+			var receivedBody struct {
+				Headers []struct {
+					Name  string `json:"name"`
+					Value string `json:"value"`
+				} `json:"headers"`
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &receivedBody)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+			t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+			root := newRootCmd()
+			root.SetArgs([]string{
+				"email", "send",
+				"--from", "sender@example.com",
+				"--to", "test@example.com",
+				"--subject", "Priority test",
+				"--text", "body",
+				"--priority", tt.priority,
+			})
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("command returned error: %v", err)
+			}
+
+			got := make(map[string]string)
+			for _, h := range receivedBody.Headers {
+				got[h.Name] = h.Value
+			}
+			if got["X-Priority"] != tt.xPriority {
+				t.Errorf("expected X-Priority %q, got %q", tt.xPriority, got["X-Priority"])
+			}
+			if got["Importance"] != tt.importance {
+				t.Errorf("expected Importance %q, got %q", tt.importance, got["Importance"])
+			}
+		})
+	}
+}
+
+func TestSendCmd_InvalidPriority(t *testing.T) {
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--to", "test@example.com",
+		"--subject", "Priority test",
+		"--text", "body",
+		"--priority", "urgent",
+	})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("expected error for invalid --priority value")
+	}
+}
+
+func TestDedupeRecipients(t *testing.T) {
+	tests := []struct {
+		name            string
+		to, cc, bcc     string
+		from            string
+		noSelfCC        bool
+		wantCC, wantBCC []string
+	}{
+		{
+			name:    "duplicates keep highest placement",
+			to:      "a@example.com",
+			cc:      "A@example.com, b@example.com, b@example.com",
+			bcc:     "b@example.com,c@example.com,a@example.com",
+			wantCC:  []string{"b@example.com"},
+			wantBCC: []string{"c@example.com"},
+		},
+		{
+			name:    "from kept without no-self-cc",
+			to:      "a@example.com",
+			cc:      "me@example.com",
+			bcc:     "me@example.com",
+			from:    "me@example.com",
+			wantCC:  []string{"me@example.com"},
+			wantBCC: nil,
+		},
+		{
+			name:     "from stripped with no-self-cc",
+			to:       "a@example.com",
+			cc:       "me@example.com,b@example.com",
+			bcc:      "Me@example.com",
+			from:     "me@example.com",
+			noSelfCC: true,
+			wantCC:   []string{"b@example.com"},
+			wantBCC:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc, bcc := dedupeRecipients(tt.to, tt.cc, tt.bcc, tt.from, tt.noSelfCC)
+			if strings.Join(cc, ",") != strings.Join(tt.wantCC, ",") {
+				t.Errorf("cc = %v, want %v", cc, tt.wantCC)
+			}
+			if strings.Join(bcc, ",") != strings.Join(tt.wantBCC, ",") {
+				t.Errorf("bcc = %v, want %v", bcc, tt.wantBCC)
+			}
+		})
+	}
+}
+
+func TestSendCmd_NoSelfCC(t *testing.T) {
+	var receivedBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedBody)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "me@example.com",
+		"--to", "test@example.com",
+		"--cc", "test@example.com,me@example.com",
+		"--bcc", "me@example.com",
+		"--subject", "Dedupe",
+		"--text", "Hi",
+		"--no-self-cc",
+	})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if _, ok := receivedBody["cc"]; ok {
+		t.Errorf("expected no cc after dedupe, got %v", receivedBody["cc"])
+	}
+	if _, ok := receivedBody["bcc"]; ok {
+		t.Errorf("expected no bcc after dedupe, got %v", receivedBody["bcc"])
+	}
+}
+
 func TestSendCmd_MissingTo(t *testing.T) {
 	// When --to is not provided and stdin is not a tty, RequireArg returns
 	// an error. Tests run non-interactively, so this should fail.
@@ -310,3 +581,276 @@ func TestSendCmd_MissingTo(t *testing.T) {
 		t.Fatal("expected error when --to is not provided")
 	}
 }
+
+func TestSendCmd_Attachments(t *testing.T) {
+	dir := t.TempDir()
+	pdfPath := filepath.Join(dir, "report.pdf")
+	csvPath := filepath.Join(dir, "data.csv")
+	logoPath := filepath.Join(dir, "logo.png")
+	for path, content := range map[string]string{pdfPath: "%PDF", csvPath: "a,b\n", logoPath: "PNG"} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var receivedBody struct {
+		Attachments []struct {
+			Content     string `json:"content"`
+			Filename    string `json:"filename"`
+			Disposition string `json:"disposition"`
+			ID          string `json:"id"`
+		} `json:"attachments"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedBody)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "sender@example.com",
+		"--to", "test@example.com",
+		"--subject", "Attachments",
+		"--html", `<img src="cid:logo">`,
+		"--attach", pdfPath,
+		"--attach", csvPath,
+		"--attach-inline", logoPath + ":logo",
+	})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if len(receivedBody.Attachments) != 3 {
+		t.Fatalf("expected 3 attachments, got %d", len(receivedBody.Attachments))
+	}
+	pdf := receivedBody.Attachments[0]
+	if pdf.Filename != "report.pdf" || pdf.Content != "JVBERg==" || pdf.Disposition != "attachment" {
+		t.Errorf("unexpected first attachment: %+v", pdf)
+	}
+	if receivedBody.Attachments[1].Filename != "data.csv" {
+		t.Errorf("expected second attachment data.csv, got %q", receivedBody.Attachments[1].Filename)
+	}
+	logo := receivedBody.Attachments[2]
+	if logo.Filename != "logo.png" || logo.Disposition != "inline" || logo.ID != "logo" {
+		t.Errorf("unexpected inline attachment: %+v", logo)
+	}
+}
+
+func TestSendCmd_AttachmentErrors(t *testing.T) {
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing file", []string{"--attach", filepath.Join(t.TempDir(), "nope.pdf")}, "failed to read attachment"},
+		{"inline without cid", []string{"--attach-inline", "logo.png"}, "use path:cid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := newRootCmd()
+			root.SetArgs(append([]string{"email", "send", "--to", "test@example.com", "--text", "Hi"}, tt.args...))
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestSendCmd_RecipientFiles(t *testing.T) {
+	dir := t.TempDir()
+	toPath := filepath.Join(dir, "to.txt")
+	ccPath := filepath.Join(dir, "cc.txt")
+	if err := os.WriteFile(toPath, []byte("# newsletter list\nalice@example.com\n\nBob Smith <bob@example.com>\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(ccPath, []byte("carol@example.com\n  # inactive: dave@example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	type recipient struct {
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	var receivedBody struct {
+		To []recipient `json:"to"`
+		CC []recipient `json:"cc"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedBody)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "sender@example.com",
+		"--to", "first@example.com",
+		"--to-file", toPath,
+		"--cc", "erin@example.com",
+		"--cc-file", ccPath,
+		"--subject", "Files",
+		"--text", "Hi",
+	})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	wantTo := []recipient{
+		{Email: "first@example.com"},
+		{Email: "alice@example.com"},
+		{Email: "bob@example.com", Name: "Bob Smith"},
+	}
+	if len(receivedBody.To) != len(wantTo) {
+		t.Fatalf("to = %+v, want %+v", receivedBody.To, wantTo)
+	}
+	for i, want := range wantTo {
+		if receivedBody.To[i] != want {
+			t.Errorf("to[%d] = %+v, want %+v", i, receivedBody.To[i], want)
+		}
+	}
+
+	var cc []string
+	for _, r := range receivedBody.CC {
+		cc = append(cc, r.Email)
+	}
+	if strings.Join(cc, ",") != "erin@example.com,carol@example.com" {
+		t.Errorf("cc = %v, want erin and carol", cc)
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := parseHeaders([]string{
+		"List-Unsubscribe: <https://example.com/unsub?u=1>",
+		"X-Entity-Ref-ID:abc-123",
+		"Keywords: one",
+		"keywords: two",
+	})
+	if err != nil {
+		t.Fatalf("parseHeaders error: %v", err)
+	}
+	if len(headers) != 4 {
+		t.Fatalf("expected 4 headers, got %d", len(headers))
+	}
+	if headers[0].Name != "List-Unsubscribe" || headers[0].Value != "<https://example.com/unsub?u=1>" {
+		t.Errorf("unexpected first header: %+v", headers[0])
+	}
+	if headers[1].Value != "abc-123" {
+		t.Errorf("value = %q, want %q", headers[1].Value, "abc-123")
+	}
+
+	for _, bad := range [][]string{
+		{"NoColon"},
+		{": value"},
+		{"Bad Name: value"},
+		{"X-Ref: 1", "x-ref: 2"},
+	} {
+		if _, err := parseHeaders(bad); err == nil {
+			t.Errorf("parseHeaders(%q) expected error, got nil", bad)
+		}
+	}
+}
+
+func TestSendCmd_CustomHeaders(t *testing.T) {
+	var receivedBody struct {
+		Headers []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"headers"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &receivedBody)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "sender@example.com",
+		"--to", "test@example.com",
+		"--subject", "Headers",
+		"--text", "Hi",
+		"--header", "List-Unsubscribe: <mailto:unsub@example.com>",
+		"--header", "X-Entity-Ref-ID: 42",
+	})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if len(receivedBody.Headers) != 2 {
+		t.Fatalf("expected 2 headers, got %+v", receivedBody.Headers)
+	}
+	if receivedBody.Headers[1].Name != "X-Entity-Ref-ID" || receivedBody.Headers[1].Value != "42" {
+		t.Errorf("unexpected header: %+v", receivedBody.Headers[1])
+	}
+}
+
+func TestSendCmd_DryRunPrintsMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("dry run made an HTTP request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{
+		"email", "send",
+		"--from", "sender@example.com",
+		"--to", "test@example.com",
+		"--subject", "Dry run",
+		"--text", "body",
+		"--track-opens",
+		"--dry-run",
+		"--json",
+	})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var msg map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &msg); err != nil {
+		t.Fatalf("dry run output is not JSON: %v\n%s", err, out)
+	}
+	if from, _ := msg["from"].(map[string]interface{}); from["email"] != "sender@example.com" {
+		t.Errorf("from = %v, want sender@example.com", msg["from"])
+	}
+	to, _ := msg["to"].([]interface{})
+	if len(to) != 1 || to[0].(map[string]interface{})["email"] != "test@example.com" {
+		t.Errorf("to = %v, want test@example.com", msg["to"])
+	}
+	if msg["subject"] != "Dry run" {
+		t.Errorf("subject = %v, want Dry run", msg["subject"])
+	}
+	if settings, _ := msg["settings"].(map[string]interface{}); settings["track_opens"] != true {
+		t.Errorf("settings = %v, want track_opens true", msg["settings"])
+	}
+}
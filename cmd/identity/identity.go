@@ -27,15 +27,17 @@ func init() {
 	Cmd.AddCommand(deleteCmd)
 
 	listCmd.Flags().Int("limit", 0, "maximum number of identities to return (0 = all)")
+	cmdutil.AddPageFlags(listCmd)
 	listCmd.Flags().String("domain", "", "filter by domain name or ID")
 
-	createCmd.Flags().String("domain", "", "domain name or ID (required)")
+	createCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	createCmd.Flags().String("name", "", "sender name (required)")
 	createCmd.Flags().String("email", "", "sender email (required)")
 	createCmd.Flags().String("reply-to-email", "", "reply-to email")
 	createCmd.Flags().String("reply-to-name", "", "reply-to name")
 	createCmd.Flags().Bool("add-note", false, "add personal note")
 	createCmd.Flags().String("personal-note", "", "personal note text")
+	createCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
 
 	updateCmd.Flags().String("name", "", "sender name")
 	updateCmd.Flags().String("reply-to-email", "", "reply-to email")
@@ -44,13 +46,29 @@ func init() {
 	updateCmd.Flags().String("personal-note", "", "personal note text")
 }
 
+// ifaceStr renders an optional API value, distinguishing a field the API
+// returned as null from one set to an empty string.
 func ifaceStr(v interface{}) string {
-	if v == nil {
-		return ""
+	switch v {
+	case nil:
+		return "(not set)"
+	case "":
+		return "(empty)"
 	}
 	return fmt.Sprintf("%v", v)
 }
 
+// domainLabel renders an identity's domain as "name (id)".
+func domainLabel(d mailersend.IdentityDomain) string {
+	switch {
+	case d.ID == "":
+		return d.Name
+	case d.Name == "":
+		return d.ID
+	}
+	return fmt.Sprintf("%s (%s)", d.Name, d.ID)
+}
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List sender identities",
@@ -71,7 +89,7 @@ var listCmd = &cobra.Command{
 			}
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Identity, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.Identity, bool, error) {
 			root, _, err := ms.Identity.List(ctx, &mailersend.ListIdentityOptions{
 				DomainID: domainID,
 				Page:     page,
@@ -96,7 +114,7 @@ var listCmd = &cobra.Command{
 			rows = append(rows, []string{i.ID, i.Name, i.Email})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -127,6 +145,10 @@ var getCmd = &cobra.Command{
 		}
 
 		d := result.Data
+		addNote := "No"
+		if d.AddNote {
+			addNote = "Yes"
+		}
 		headers := []string{"FIELD", "VALUE"}
 		rows := [][]string{
 			{"ID", d.ID},
@@ -134,9 +156,11 @@ var getCmd = &cobra.Command{
 			{"Email", d.Email},
 			{"Reply-To Email", ifaceStr(d.ReplyToEmail)},
 			{"Reply-To Name", ifaceStr(d.ReplyToName)},
+			{"Domain", domainLabel(d.Domain)},
+			{"Add Note", addNote},
 			{"Personal Note", ifaceStr(d.PersonalNote)},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -152,7 +176,7 @@ var createCmd = &cobra.Command{
 
 		ctx := context.Background()
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -202,6 +226,9 @@ var createCmd = &cobra.Command{
 		}
 
 		output.Success("Identity created successfully. ID: " + result.Data.ID)
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(result.Data)
+		}
 		return nil
 	},
 }
@@ -280,6 +307,10 @@ var deleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("identity", args[0])
+		}
+
 		output.Success("Identity " + args[0] + " deleted successfully.")
 		return nil
 	},
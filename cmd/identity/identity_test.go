@@ -0,0 +1,94 @@
+package identity
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestIdentityGetCmd_RendersDomainAndNoteFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identities/id-1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {
+			"id": "id-1",
+			"email": "hello@example.com",
+			"name": "Hello",
+			"reply_to_email": null,
+			"reply_to_name": "",
+			"add_note": true,
+			"personal_note": "Welcome aboard",
+			"domain": {"id": "dom-1", "name": "example.com"}
+		}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"identity", "get", "id-1"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	for _, want := range [][]string{
+		{"Domain", "example.com (dom-1)"},
+		{"Add Note", "Yes"},
+		{"Personal Note", "Welcome aboard"},
+		{"Reply-To Email", "(not set)"},
+		{"Reply-To Name", "(empty)"},
+	} {
+		if !hasRow(out, want[0], want[1]) {
+			t.Errorf("expected row %q = %q in output:\n%s", want[0], want[1], out)
+		}
+	}
+}
+
+// hasRow reports whether a table line contains both field and value.
+func hasRow(out, field, value string) bool {
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, field) && strings.Contains(line, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,7 +2,9 @@ package inbound
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
@@ -29,10 +31,23 @@ func parseForwards(raw []string) []mailersend.ForwardsFilter {
 	return out
 }
 
+// mergeJSONFile decodes the JSON object in path over opts. Keys absent from
+// the file leave the existing values untouched.
+func mergeJSONFile(path string, opts *mailersend.UpdateInboundOptions) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read JSON file: %w", err)
+	}
+	if err := json.Unmarshal(data, opts); err != nil {
+		return fmt.Errorf("failed to parse JSON file: %w", err)
+	}
+	return nil
+}
+
 var Cmd = &cobra.Command{
 	Use:   "inbound",
 	Short: "Manage inbound routes",
-	Long:  "List, view, create, update, and delete inbound routes.",
+	Long:  "List, view, create, update, enable, disable, and delete inbound routes.",
 }
 
 func init() {
@@ -40,12 +55,15 @@ func init() {
 	Cmd.AddCommand(getCmd)
 	Cmd.AddCommand(createCmd)
 	Cmd.AddCommand(updateCmd)
+	Cmd.AddCommand(enableCmd)
+	Cmd.AddCommand(disableCmd)
 	Cmd.AddCommand(deleteCmd)
 
 	listCmd.Flags().Int("limit", 0, "maximum number of routes to return (0 = all)")
-	listCmd.Flags().String("domain", "", "domain name or ID (required)")
+	cmdutil.AddPageFlags(listCmd)
+	listCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 
-	createCmd.Flags().String("domain", "", "domain name or ID (required)")
+	createCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	createCmd.Flags().String("name", "", "route name (required)")
 	createCmd.Flags().Bool("domain-enabled", true, "whether the domain is enabled")
 	createCmd.Flags().String("inbound-domain", "", "inbound domain (required when domain-enabled is true)")
@@ -54,6 +72,7 @@ func init() {
 	createCmd.Flags().String("catch-filter-type", "", "catch filter type (required when domain-enabled, e.g. catch_all, catch_recipient)")
 	createCmd.Flags().String("match-filter-type", "", "match filter type (required, e.g. match_all, match_recipient)")
 	createCmd.Flags().StringSlice("forwards", nil, "forward URLs as type:value pairs, e.g. 'webhook:https://example.com' (required)")
+	createCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
 
 	updateCmd.Flags().String("name", "", "route name")
 	updateCmd.Flags().Bool("domain-enabled", true, "whether the domain is enabled")
@@ -63,6 +82,7 @@ func init() {
 	updateCmd.Flags().String("catch-filter-type", "", "catch filter type")
 	updateCmd.Flags().String("match-filter-type", "", "match filter type")
 	updateCmd.Flags().StringSlice("forwards", nil, "forward URLs as type:value pairs, e.g. 'webhook:https://example.com'")
+	updateCmd.Flags().String("from-json", "", "path to a JSON file with fields to merge over the current route (flags take precedence)")
 }
 
 var listCmd = &cobra.Command{
@@ -75,7 +95,7 @@ var listCmd = &cobra.Command{
 		}
 
 		limit, _ := c.Flags().GetInt("limit")
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -86,7 +106,7 @@ var listCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Inbound, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.Inbound, bool, error) {
 			root, _, err := ms.Inbound.List(ctx, &mailersend.ListInboundOptions{
 				DomainID: domainID,
 				Page:     page,
@@ -105,13 +125,31 @@ var listCmd = &cobra.Command{
 			return output.JSON(items)
 		}
 
-		headers := []string{"ID", "NAME"}
+		// Resolve each distinct domain reference once. Routes without one
+		// belong to the --domain being listed.
+		names := map[string]string{}
+		domainName := func(ref string) string {
+			if ref == "" {
+				ref = domainID
+			}
+			if name, ok := names[ref]; ok {
+				return name
+			}
+			name, err := cmdutil.ResolveDomainNameSDK(ms, ref)
+			if err != nil {
+				name = ref
+			}
+			names[ref] = name
+			return name
+		}
+
+		headers := []string{"ID", "NAME", "DOMAIN"}
 		var rows [][]string
 		for _, item := range items {
-			rows = append(rows, []string{item.ID, item.Name})
+			rows = append(rows, []string{item.ID, item.Name, domainName(item.Domain)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -148,7 +186,7 @@ var getCmd = &cobra.Command{
 			{"Domain Enabled", enabled},
 			{"Inbound Domain", d.Domain},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -162,7 +200,7 @@ var createCmd = &cobra.Command{
 			return err
 		}
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -230,6 +268,9 @@ var createCmd = &cobra.Command{
 		}
 
 		output.Success("Inbound route created successfully. ID: " + result.Data.ID)
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(result.Data)
+		}
 		return nil
 	},
 }
@@ -251,72 +292,45 @@ var updateCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to fetch current route: %w", sdkclient.WrapError(err))
 		}
-		d := current.Data
-
-		// Start with current values.
-		name := d.Name
-		domainEnabled := d.Enabled
-		inboundDomain := d.Domain
-		inboundPriority := d.Priority
-
-		// Build match_filter and catch_filter from existing filters.
-		var matchFilter *mailersend.MatchFilter
-		var catchFilter *mailersend.CatchFilter
-		for _, f := range d.Filters {
-			switch f.Type {
-			case "match_all", "match_sender", "match_domain", "match_recipient":
-				matchFilter = &mailersend.MatchFilter{Type: f.Type}
-			case "catch_all", "catch_recipient":
-				catchFilter = &mailersend.CatchFilter{Type: f.Type, Filters: []mailersend.Filter{}}
-			}
-		}
-		if matchFilter == nil {
-			matchFilter = &mailersend.MatchFilter{Type: "match_all"}
-		}
-		if catchFilter == nil {
-			catchFilter = &mailersend.CatchFilter{Type: "catch_all", Filters: []mailersend.Filter{}}
-		}
+		opts := routeUpdateOptions(current.Data)
 
-		// Build forwards from current.
-		fwds := make([]mailersend.ForwardsFilter, 0, len(d.Forwards))
-		for _, fw := range d.Forwards {
-			fwds = append(fwds, mailersend.ForwardsFilter{Type: fw.Type, Value: fw.Value})
+		// Overlay a partial JSON object; only keys present in the file change.
+		if path, _ := c.Flags().GetString("from-json"); path != "" {
+			if err := mergeJSONFile(path, opts); err != nil {
+				return err
+			}
+			if opts.DomainID != "" {
+				opts.DomainID, err = cmdutil.ResolveDomainSDK(ms, opts.DomainID)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		// Override with user-provided flags.
 		if c.Flags().Changed("name") {
-			name, _ = c.Flags().GetString("name")
+			opts.Name, _ = c.Flags().GetString("name")
 		}
 		if c.Flags().Changed("domain-enabled") {
-			domainEnabled, _ = c.Flags().GetBool("domain-enabled")
+			opts.DomainEnabled, _ = c.Flags().GetBool("domain-enabled")
 		}
 		if c.Flags().Changed("inbound-domain") {
-			inboundDomain, _ = c.Flags().GetString("inbound-domain")
+			opts.InboundDomain, _ = c.Flags().GetString("inbound-domain")
 		}
 		if c.Flags().Changed("inbound-priority") {
-			inboundPriority, _ = c.Flags().GetInt("inbound-priority")
+			opts.InboundPriority, _ = c.Flags().GetInt("inbound-priority")
 		}
 		if c.Flags().Changed("catch-filter-type") {
 			v, _ := c.Flags().GetString("catch-filter-type")
-			catchFilter = &mailersend.CatchFilter{Type: v, Filters: []mailersend.Filter{}}
+			opts.CatchFilter = &mailersend.CatchFilter{Type: v, Filters: []mailersend.Filter{}}
 		}
 		if c.Flags().Changed("match-filter-type") {
 			v, _ := c.Flags().GetString("match-filter-type")
-			matchFilter = &mailersend.MatchFilter{Type: v}
+			opts.MatchFilter = &mailersend.MatchFilter{Type: v}
 		}
 		if c.Flags().Changed("forwards") {
 			v, _ := c.Flags().GetStringSlice("forwards")
-			fwds = parseForwards(v)
-		}
-
-		opts := &mailersend.UpdateInboundOptions{
-			Name:            name,
-			DomainEnabled:   domainEnabled,
-			InboundDomain:   inboundDomain,
-			InboundPriority: inboundPriority,
-			MatchFilter:     matchFilter,
-			CatchFilter:     catchFilter,
-			Forwards:        fwds,
+			opts.Forwards = parseForwards(v)
 		}
 
 		result, _, err := ms.Inbound.Update(ctx, args[0], opts)
@@ -333,6 +347,144 @@ var updateCmd = &cobra.Command{
 	},
 }
 
+// routeUpdateOptions builds an update payload carrying every field of the
+// current route, since the API requires all fields on PUT.
+func routeUpdateOptions(d mailersend.Inbound) *mailersend.UpdateInboundOptions {
+	// Build match_filter and catch_filter from existing filters, keeping
+	// each catch rule's comparer and value.
+	var matchFilter *mailersend.MatchFilter
+	var catchFilter *mailersend.CatchFilter
+	for _, f := range d.Filters {
+		switch f.Type {
+		case "match_all", "match_sender", "match_domain", "match_recipient":
+			matchFilter = &mailersend.MatchFilter{Type: f.Type}
+		case "catch_all", "catch_recipient":
+			if catchFilter == nil {
+				catchFilter = &mailersend.CatchFilter{Type: f.Type, Filters: []mailersend.Filter{}}
+			}
+			if f.Comparer != "" || f.Value != "" {
+				catchFilter.Filters = append(catchFilter.Filters, routeFilter(f))
+			}
+		}
+	}
+	if matchFilter == nil {
+		matchFilter = &mailersend.MatchFilter{Type: "match_all"}
+	}
+	if catchFilter == nil {
+		catchFilter = &mailersend.CatchFilter{Type: "catch_all", Filters: []mailersend.Filter{}}
+	}
+
+	// Build forwards from current.
+	fwds := make([]mailersend.ForwardsFilter, 0, len(d.Forwards))
+	for _, fw := range d.Forwards {
+		fwds = append(fwds, mailersend.ForwardsFilter{Type: fw.Type, Value: fw.Value})
+	}
+
+	return &mailersend.UpdateInboundOptions{
+		Name:            d.Name,
+		DomainEnabled:   d.Enabled,
+		InboundDomain:   d.Domain,
+		InboundPriority: d.Priority,
+		MatchFilter:     matchFilter,
+		CatchFilter:     catchFilter,
+		Forwards:        fwds,
+	}
+}
+
+// routeFilter converts a rule of a fetched route to the form update payloads
+// carry it in.
+func routeFilter(f mailersend.Filters) mailersend.Filter {
+	key, _ := f.Key.(string)
+	return mailersend.Filter{Comparer: f.Comparer, Value: f.Value, Key: key}
+}
+
+// routeUpdate is an update payload whose match_filter also carries the
+// route's match rules, which the SDK's MatchFilter has no field for.
+type routeUpdate struct {
+	*mailersend.UpdateInboundOptions
+	MatchFilter routeMatchFilter `json:"match_filter"`
+}
+
+type routeMatchFilter struct {
+	Type    string              `json:"type"`
+	Filters []mailersend.Filter `json:"filters,omitempty"`
+}
+
+// fullRouteUpdate builds an update payload that keeps every setting and rule
+// of the current route.
+func fullRouteUpdate(d mailersend.Inbound) routeUpdate {
+	opts := routeUpdateOptions(d)
+	update := routeUpdate{UpdateInboundOptions: opts, MatchFilter: routeMatchFilter{Type: opts.MatchFilter.Type}}
+	for _, f := range d.Filters {
+		if f.Type == opts.MatchFilter.Type && (f.Comparer != "" || f.Value != "") {
+			update.MatchFilter.Filters = append(update.MatchFilter.Filters, routeFilter(f))
+		}
+	}
+	return update
+}
+
+var enableCmd = &cobra.Command{
+	Use:   "enable <id>",
+	Short: "Enable an inbound route",
+	Long:  "Enable an inbound route, keeping all of its other settings.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return setRouteEnabled(c, args[0], true)
+	},
+}
+
+var disableCmd = &cobra.Command{
+	Use:   "disable <id>",
+	Short: "Disable an inbound route",
+	Long:  "Disable an inbound route, keeping all of its other settings.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return setRouteEnabled(c, args[0], false)
+	},
+}
+
+// setRouteEnabled fetches the route and writes it back with only its
+// enabled state changed. A route already in that state is left alone.
+func setRouteEnabled(c *cobra.Command, id string, enabled bool) error {
+	ms, err := cmdutil.NewSDKClient(c)
+	if err != nil {
+		return err
+	}
+
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+
+	ctx := context.Background()
+	current, _, err := ms.Inbound.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to fetch current route: %w", sdkclient.WrapError(err))
+	}
+	if current.Data.Enabled == enabled {
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(current)
+		}
+		output.Note("Inbound route " + id + " is already " + state + ".")
+		return nil
+	}
+
+	update := fullRouteUpdate(current.Data)
+	update.DomainEnabled = enabled
+
+	var result mailersend.SingleInboundRoot
+	if err := sdkclient.PutJSON(ctx, ms, "/inbound/"+id, update, &result); err != nil {
+		return err
+	}
+
+	if cmdutil.JSONFlag(c) {
+		return output.JSON(result)
+	}
+
+	output.Success("Inbound route " + id + " " + state + ".")
+	return nil
+}
+
 var deleteCmd = &cobra.Command{
 	Use:   "delete <id>",
 	Short: "Delete an inbound route",
@@ -349,6 +501,10 @@ var deleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("inbound_route", args[0])
+		}
+
 		output.Success("Inbound route " + args[0] + " deleted successfully.")
 		return nil
 	},
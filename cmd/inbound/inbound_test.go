@@ -0,0 +1,246 @@
+package inbound
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestInboundListCmd_DomainColumn(t *testing.T) {
+	var domainLookups int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/domains":
+			domainLookups++
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{
+					{"id": "dom-1", "name": "example.com"},
+					{"id": "dom-2", "name": "other.com"},
+				},
+			})
+		case "/inbound":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]interface{}{
+					{"id": "route-1", "name": "Support", "domain": "dom-1"},
+					{"id": "route-2", "name": "Sales", "domain": "dom-1"},
+					{"id": "route-3", "name": "Legacy", "domain": ""},
+				},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"inbound", "list", "--domain", "dom-1"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "DOMAIN") {
+		t.Errorf("expected DOMAIN column, got:\n%s", out)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "route-") && !strings.Contains(line, "example.com") {
+			t.Errorf("expected resolved domain name in row %q", line)
+		}
+	}
+	if domainLookups != 1 {
+		t.Errorf("expected domains to be listed once, got %d", domainLookups)
+	}
+}
+
+func TestInboundUpdateCmd_FromJSONMergesOnlyProvidedKeys(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "route.json")
+	partial := `{"name": "Renamed", "forwards": [{"type": "email", "value": "ops@example.com"}]}`
+	if err := os.WriteFile(jsonPath, []byte(partial), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var putBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &putBody)
+		}
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":       "route-1",
+				"name":     "Original",
+				"domain":   "inbound.example.com",
+				"priority": 50,
+				"enabled":  true,
+				"filters": []map[string]interface{}{
+					{"type": "match_sender"},
+					{"type": "catch_recipient"},
+				},
+				"forwards": []map[string]interface{}{
+					{"type": "webhook", "value": "https://example.com/hook"},
+				},
+			},
+		}
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"inbound", "update", "route-1", "--from-json", jsonPath})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if putBody == nil {
+		t.Fatal("expected a PUT request")
+	}
+
+	// Keys from the file override.
+	if putBody["name"] != "Renamed" {
+		t.Errorf("expected name Renamed, got %v", putBody["name"])
+	}
+	fwds, ok := putBody["forwards"].([]interface{})
+	if !ok || len(fwds) != 1 {
+		t.Fatalf("expected 1 forward, got %v", putBody["forwards"])
+	}
+	if fw := fwds[0].(map[string]interface{}); fw["type"] != "email" || fw["value"] != "ops@example.com" {
+		t.Errorf("expected email forward from file, got %v", fw)
+	}
+
+	// Everything else keeps the current route's values.
+	if putBody["inbound_domain"] != "inbound.example.com" {
+		t.Errorf("expected inbound_domain unchanged, got %v", putBody["inbound_domain"])
+	}
+	if putBody["inbound_priority"] != float64(50) {
+		t.Errorf("expected inbound_priority unchanged, got %v", putBody["inbound_priority"])
+	}
+	if putBody["domain_enabled"] != true {
+		t.Errorf("expected domain_enabled unchanged, got %v", putBody["domain_enabled"])
+	}
+	if mf := putBody["match_filter"].(map[string]interface{}); mf["type"] != "match_sender" {
+		t.Errorf("expected match_filter unchanged, got %v", mf)
+	}
+	if cf := putBody["catch_filter"].(map[string]interface{}); cf["type"] != "catch_recipient" {
+		t.Errorf("expected catch_filter unchanged, got %v", cf)
+	}
+}
+
+func TestInboundDisableCmd_OnlyEnabledChanges(t *testing.T) {
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &putBody)
+		}
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":       "route-1",
+				"name":     "Original",
+				"domain":   "inbound.example.com",
+				"priority": 50,
+				"enabled":  true,
+				"filters": []map[string]interface{}{
+					{"type": "match_sender", "key": nil, "comparer": "equal", "value": "alerts@example.com"},
+					{"type": "catch_recipient", "key": nil, "comparer": "equal", "value": "support"},
+					{"type": "catch_recipient", "key": nil, "comparer": "starts-with", "value": "help"},
+				},
+				"forwards": []map[string]interface{}{
+					{"type": "webhook", "value": "https://example.com/hook"},
+				},
+			},
+		}
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"inbound", "disable", "route-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if putBody == nil {
+		t.Fatal("expected a PUT request")
+	}
+	if putBody["domain_enabled"] != false {
+		t.Errorf("expected domain_enabled false, got %v", putBody["domain_enabled"])
+	}
+	want := map[string]interface{}{
+		"name":             "Original",
+		"inbound_domain":   "inbound.example.com",
+		"inbound_priority": float64(50),
+	}
+	for k, v := range want {
+		if putBody[k] != v {
+			t.Errorf("expected %s unchanged (%v), got %v", k, v, putBody[k])
+		}
+	}
+	mf, _ := json.Marshal(putBody["match_filter"])
+	if want := `{"filters":[{"comparer":"equal","value":"alerts@example.com"}],"type":"match_sender"}`; string(mf) != want {
+		t.Errorf("expected match_filter unchanged, got %s", mf)
+	}
+	cf, _ := json.Marshal(putBody["catch_filter"])
+	if want := `{"filters":[{"comparer":"equal","value":"support"},{"comparer":"starts-with","value":"help"}],"type":"catch_recipient"}`; string(cf) != want {
+		t.Errorf("expected catch_filter unchanged, got %s", cf)
+	}
+	if fwds, ok := putBody["forwards"].([]interface{}); !ok || len(fwds) != 1 {
+		t.Errorf("expected forwards unchanged, got %v", putBody["forwards"])
+	}
+}
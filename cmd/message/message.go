@@ -3,6 +3,7 @@ package message
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
@@ -35,13 +36,19 @@ func init() {
 
 	f := listCmd.Flags()
 	f.Int("limit", 25, "maximum number of results to return")
+	cmdutil.AddPageFlags(listCmd)
 	f.String("status", "", "filter by status (queued|sent|delivered|failed)")
 	f.String("domain", "", "filter by domain name or ID")
 	f.String("date-from", "", "filter from date (YYYY-MM-DD or unix timestamp)")
 	f.String("date-to", "", "filter to date (YYYY-MM-DD or unix timestamp)")
 
+	gf := getCmd.Flags()
+	gf.Bool("emails", false, "list every email in the message")
+	gf.String("email-status", "", "only show emails in this status, e.g. failed (implies --emails)")
+
 	sf := scheduledListCmd.Flags()
 	sf.Int("limit", 25, "maximum number of results to return")
+	cmdutil.AddPageFlags(scheduledListCmd)
 	sf.String("status", "", "filter by status (scheduled|sending|sent|error)")
 	sf.String("domain", "", "filter by domain name or ID")
 }
@@ -62,7 +69,7 @@ func runList(cobraCmd *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
-	items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.MessageData, bool, error) {
+	items, err := cmdutil.FetchList(ctx, cobraCmd, func(ctx context.Context, page, perPage int) ([]mailersend.MessageData, bool, error) {
 		root, _, err := ms.Message.List(ctx, &mailersend.ListMessageOptions{
 			Page:  page,
 			Limit: perPage,
@@ -85,12 +92,12 @@ func runList(cobraCmd *cobra.Command, args []string) error {
 	for _, item := range items {
 		rows = append(rows, []string{
 			item.ID,
-			item.CreatedAt.Format("2006-01-02 15:04:05"),
-			item.UpdatedAt.Format("2006-01-02 15:04:05"),
+			output.FormatTime(item.CreatedAt, "2006-01-02 15:04:05"),
+			output.FormatTime(item.UpdatedAt, "2006-01-02 15:04:05"),
 		})
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
@@ -116,16 +123,33 @@ func runGet(cobraCmd *cobra.Command, args []string) error {
 		return sdkclient.WrapError(err)
 	}
 
+	showEmails, _ := cobraCmd.Flags().GetBool("emails")
+	emailStatus, _ := cobraCmd.Flags().GetString("email-status")
+	if emailStatus != "" {
+		showEmails = true
+		result.Data.Emails = filterEmailsByStatus(result.Data.Emails, emailStatus)
+	}
+
 	if cmdutil.JSONFlag(cobraCmd) {
 		return output.JSON(result)
 	}
 
 	d := result.Data
+	if showEmails {
+		headers := []string{"ID", "FROM", "SUBJECT", "STATUS"}
+		var rows [][]string
+		for _, e := range d.Emails {
+			rows = append(rows, []string{e.ID, e.From, output.Truncate(e.Subject, 40), e.Status})
+		}
+		output.Render(headers, rows)
+		return nil
+	}
+
 	headers := []string{"FIELD", "VALUE"}
 	rows := [][]string{
 		{"ID", d.ID},
-		{"Created At", d.CreatedAt.Format("2006-01-02 15:04:05")},
-		{"Updated At", d.UpdatedAt.Format("2006-01-02 15:04:05")},
+		{"Created At", output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")},
+		{"Updated At", output.FormatTime(d.UpdatedAt, "2006-01-02 15:04:05")},
 		{"Domain", d.Domain.Name},
 	}
 
@@ -136,16 +160,58 @@ func runGet(cobraCmd *cobra.Command, args []string) error {
 			[]string{"From", e.From},
 			[]string{"Status", e.Status},
 		)
+		if templateID := firstTemplateID(ctx, ms, messageID); templateID != "" {
+			rows = append(rows, []string{"Template", templateLabel(ctx, ms, templateID)})
+		}
 	}
 
 	if len(d.Emails) > 1 {
 		rows = append(rows, []string{"Email Count", fmt.Sprintf("%d", len(d.Emails))})
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
+// firstTemplateID returns the template ID of the message's first email, or
+// "" if it has none. The SDK's Email type drops template_id, so the message
+// is fetched raw; a failed lookup just leaves the Template row out.
+func firstTemplateID(ctx context.Context, ms *mailersend.Mailersend, messageID string) string {
+	var raw struct {
+		Data struct {
+			Emails []struct {
+				TemplateID string `json:"template_id"`
+			} `json:"emails"`
+		} `json:"data"`
+	}
+	if err := sdkclient.GetJSON(ctx, ms, "/messages/"+messageID, &raw); err != nil || len(raw.Data.Emails) == 0 {
+		return ""
+	}
+	return raw.Data.Emails[0].TemplateID
+}
+
+// templateLabel formats a template as "name (id)". A deleted or otherwise
+// unknown template is shown by ID alone.
+func templateLabel(ctx context.Context, ms *mailersend.Mailersend, templateID string) string {
+	result, _, err := ms.Template.Get(ctx, templateID)
+	if err != nil || result.Data.Name == "" {
+		return templateID + " (unknown template)"
+	}
+	return fmt.Sprintf("%s (%s)", result.Data.Name, templateID)
+}
+
+// filterEmailsByStatus returns the emails whose status matches status,
+// ignoring case.
+func filterEmailsByStatus(emails []mailersend.Email, status string) []mailersend.Email {
+	filtered := []mailersend.Email{}
+	for _, e := range emails {
+		if strings.EqualFold(e.Status, status) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
 // --- message scheduled (subcommand group) ---
 
 var scheduledCmd = &cobra.Command{
@@ -180,7 +246,7 @@ func runScheduledList(cobraCmd *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
-	items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.ScheduleMessageData, bool, error) {
+	items, err := cmdutil.FetchList(ctx, cobraCmd, func(ctx context.Context, page, perPage int) ([]mailersend.ScheduleMessageData, bool, error) {
 		root, _, err := ms.ScheduleMessage.List(ctx, &mailersend.ListScheduleMessageOptions{
 			DomainID: domainID,
 			Status:   status,
@@ -206,13 +272,13 @@ func runScheduledList(cobraCmd *cobra.Command, args []string) error {
 		rows = append(rows, []string{
 			item.MessageID,
 			output.Truncate(item.Subject, 40),
-			item.SendAt.Format("2006-01-02 15:04:05"),
+			output.FormatTime(item.SendAt, "2006-01-02 15:04:05"),
 			item.Status,
-			item.CreatedAt,
+			output.FormatTimeString(item.CreatedAt),
 		})
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
@@ -253,16 +319,16 @@ func runScheduledGet(cobraCmd *cobra.Command, args []string) error {
 	rows := [][]string{
 		{"Message ID", d.MessageID},
 		{"Subject", d.Subject},
-		{"Send At", d.SendAt.Format("2006-01-02 15:04:05")},
+		{"Send At", output.FormatTime(d.SendAt, "2006-01-02 15:04:05")},
 		{"Status", d.Status},
 		{"Status Message", statusMsg},
-		{"Created At", d.CreatedAt.Format("2006-01-02 15:04:05")},
+		{"Created At", output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")},
 		{"Domain", d.Domain.Name},
 		{"Domain ID", d.Domain.ID},
 		{"Related Message ID", d.Message.ID},
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
@@ -289,7 +355,7 @@ func runScheduledDelete(cobraCmd *cobra.Command, args []string) error {
 	}
 
 	if cmdutil.JSONFlag(cobraCmd) {
-		return output.JSON(map[string]string{"status": "deleted", "message_id": messageID})
+		return output.Deleted("scheduled_message", messageID)
 	}
 
 	output.Success(fmt.Sprintf("Scheduled message %s deleted successfully.", messageID))
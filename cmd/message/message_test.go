@@ -0,0 +1,161 @@
+package message
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return out
+}
+
+func TestMessageGetCmd_EmailStatusFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"id": "msg-1",
+				"emails": []map[string]interface{}{
+					{"id": "e-1", "from": "a@example.com", "status": "delivered"},
+					{"id": "e-2", "from": "a@example.com", "status": "failed"},
+					{"id": "e-3", "from": "a@example.com", "status": "sent"},
+					{"id": "e-4", "from": "a@example.com", "status": "failed"},
+				},
+				"domain": map[string]interface{}{"id": "dom-1", "name": "example.com"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = getCmd.Flags().Set("emails", "false")
+		_ = getCmd.Flags().Set("email-status", "")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"message", "get", "msg-1", "--emails", "--email-status", "FAILED", "--json"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var result struct {
+		Data struct {
+			Emails []struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"emails"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+
+	emails := result.Data.Emails
+	if len(emails) != 2 {
+		t.Fatalf("expected 2 failed emails, got %d: %+v", len(emails), emails)
+	}
+	if emails[0].ID != "e-2" || emails[1].ID != "e-4" {
+		t.Errorf("expected e-2 and e-4, got %+v", emails)
+	}
+}
+
+func TestMessageGetCmd_TemplateName(t *testing.T) {
+	tests := []struct {
+		name       string
+		templateOK bool
+		want       string
+	}{
+		{"known template", true, "Welcome (tmpl-1)"},
+		{"deleted template", false, "tmpl-1 (unknown template)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/messages/msg-1":
+					resp := map[string]interface{}{
+						"data": map[string]interface{}{
+							"id": "msg-1",
+							"emails": []map[string]interface{}{
+								{"id": "e-1", "from": "a@example.com", "subject": "Hi", "status": "sent", "template_id": "tmpl-1"},
+							},
+							"domain": map[string]interface{}{"id": "dom-1", "name": "example.com"},
+						},
+					}
+					json.NewEncoder(w).Encode(resp) //nolint:errcheck
+				case "/templates/tmpl-1":
+					if !tt.templateOK {
+						w.WriteHeader(http.StatusNotFound)
+						w.Write([]byte(`{"message":"Resource not found."}`)) //nolint:errcheck
+						return
+					}
+					json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+						"data": map[string]interface{}{"id": "tmpl-1", "name": "Welcome"},
+					})
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+			t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+			root := newRootCmd()
+			root.SetArgs([]string{"message", "get", "msg-1"})
+
+			out := captureStdout(t, func() {
+				if err := root.Execute(); err != nil {
+					t.Fatalf("command returned error: %v", err)
+				}
+			})
+
+			if !strings.Contains(string(out), tt.want) {
+				t.Errorf("expected Template row %q, got:\n%s", tt.want, out)
+			}
+		})
+	}
+}
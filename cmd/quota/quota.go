@@ -38,7 +38,7 @@ var Cmd = &cobra.Command{
 			{"Used", fmt.Sprintf("%d", used)},
 			{"Remaining", fmt.Sprintf("%d", result.Remaining)},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -2,11 +2,13 @@ package recipient
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
 	"github.com/mailersend/mailersend-go"
 	"github.com/spf13/cobra"
 )
@@ -23,8 +25,10 @@ func init() {
 	Cmd.AddCommand(deleteCmd)
 
 	listCmd.Flags().Int("limit", 0, "maximum number of recipients to return (0 = all)")
+	cmdutil.AddPageFlags(listCmd)
 	listCmd.Flags().String("domain", "", "filter by domain name or ID")
 
+	getCmd.Flags().Bool("suppressions", false, "also list the suppression lists that contain the address (scans each list)")
 }
 
 var listCmd = &cobra.Command{
@@ -62,7 +66,7 @@ var listCmd = &cobra.Command{
 			fetchLimit = 0
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.RecipientObject, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.RecipientObject, bool, error) {
 			root, _, err := ms.Recipient.List(ctx, &mailersend.ListRecipientOptions{
 				Page:  page,
 				Limit: perPage,
@@ -96,10 +100,10 @@ var listCmd = &cobra.Command{
 		headers := []string{"ID", "EMAIL", "CREATED AT"}
 		var rows [][]string
 		for _, r := range items {
-			rows = append(rows, []string{r.ID, r.Email, r.CreatedAt})
+			rows = append(rows, []string{r.ID, r.Email, output.FormatTimeString(r.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -120,23 +124,69 @@ var getCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		d := result.Data
+		checkSuppressions, _ := c.Flags().GetBool("suppressions")
+		var lists []string
+		if checkSuppressions {
+			lists, err = suppressionLists(ctx, ms, d.Email, d.Domain.ID)
+			if err != nil {
+				return err
+			}
+		}
+
 		if cmdutil.JSONFlag(c) {
+			if checkSuppressions {
+				return output.JSON(recipientWithSuppressions{Data: suppressedRecipient{d, lists}})
+			}
 			return output.JSON(result)
 		}
 
-		d := result.Data
 		headers := []string{"FIELD", "VALUE"}
 		rows := [][]string{
 			{"ID", d.ID},
 			{"Email", d.Email},
-			{"Created At", d.CreatedAt},
-			{"Updated At", d.UpdatedAt},
+			{"Created At", output.FormatTimeString(d.CreatedAt)},
+			{"Updated At", output.FormatTimeString(d.UpdatedAt)},
+		}
+		if checkSuppressions {
+			value := "none"
+			if len(lists) > 0 {
+				value = strings.Join(lists, ", ")
+			}
+			rows = append(rows, []string{"Suppressions", value})
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
 
+// recipientWithSuppressions is the get --json shape with --suppressions.
+type recipientWithSuppressions struct {
+	Data suppressedRecipient `json:"data"`
+}
+
+type suppressedRecipient struct {
+	mailersend.RecipientData
+	Suppressions []string `json:"suppressions"`
+}
+
+// suppressionLists reports which suppression lists contain email, in
+// suppressions.Types order. It matches entries exactly as `suppression
+// check` does, limited to domainID when it is known.
+func suppressionLists(ctx context.Context, ms *mailersend.Mailersend, email, domainID string) ([]string, error) {
+	matches, err := suppressions.Check(ctx, ms, email, domainID)
+	if err != nil {
+		return nil, err
+	}
+	lists := []string{}
+	for _, m := range matches {
+		if !slices.Contains(lists, m.List) {
+			lists = append(lists, m.List)
+		}
+	}
+	return lists, nil
+}
+
 var deleteCmd = &cobra.Command{
 	Use:   "delete <recipient_id>",
 	Short: "Delete a recipient",
@@ -153,6 +203,10 @@ var deleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("recipient", args[0])
+		}
+
 		output.Success("Recipient " + args[0] + " deleted successfully.")
 		return nil
 	},
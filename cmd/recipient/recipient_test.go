@@ -2,8 +2,12 @@ package recipient
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -99,6 +103,7 @@ func TestRecipientListCmd_DomainFilterByName(t *testing.T) {
 		origHandler.ServeHTTP(w, r)
 	})
 
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
 	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
 	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
 
@@ -126,6 +131,7 @@ func TestRecipientListCmd_DomainFilterByID(t *testing.T) {
 	server := httptest.NewServer(recipientsMockHandler())
 	defer server.Close()
 
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
 	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
 	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
 
@@ -136,3 +142,83 @@ func TestRecipientListCmd_DomainFilterByID(t *testing.T) {
 		t.Fatalf("command returned error: %v", err)
 	}
 }
+
+func TestRecipientGetCmd_Suppressions(t *testing.T) {
+	var mu sync.Mutex
+	domainFilters := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		list := func(data []map[string]interface{}) {
+			mu.Lock()
+			domainFilters[r.URL.Path] = r.URL.Query().Get("domain_id")
+			mu.Unlock()
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data":  data,
+				"links": map[string]string{"next": ""},
+				"meta":  map[string]interface{}{},
+			})
+		}
+		switch r.URL.Path {
+		case "/recipients/rec-1":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": map[string]interface{}{
+					"id": "rec-1", "email": "Ann@Example.com",
+					"domain": map[string]interface{}{"id": "dom-1"},
+				},
+			})
+		case "/suppressions/blocklist":
+			list([]map[string]interface{}{{"id": "b1", "type": "pattern", "pattern": "*@example.com"}})
+		case "/suppressions/hard-bounces":
+			list([]map[string]interface{}{{"id": "h1", "recipient": map[string]interface{}{"email": "someone@example.com"}}})
+		case "/suppressions/spam-complaints":
+			list([]map[string]interface{}{})
+		case "/suppressions/unsubscribes":
+			list([]map[string]interface{}{{"id": "u1", "recipient": map[string]interface{}{"email": "ann@example.com"}}})
+		case "/suppressions/on-hold-list":
+			list([]map[string]interface{}{{"id": "o1", "recipient": map[string]interface{}{"email": "ann@example.com"}}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() { _ = getCmd.Flags().Set("suppressions", "false") }()
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"recipient", "get", "rec-1", "--suppressions"})
+
+	origStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	err := root.Execute()
+	w.Close() //nolint:errcheck
+	os.Stdout = origStdout
+	if err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	out, _ := io.ReadAll(r)
+
+	var parsed struct {
+		Data struct {
+			Email        string   `json:"email"`
+			Suppressions []string `json:"suppressions"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if got := strings.Join(parsed.Data.Suppressions, ","); got != "blocklist,unsubscribes,on-hold" {
+		t.Errorf("suppressions = %q, want blocklist,unsubscribes,on-hold", got)
+	}
+	for path, domain := range domainFilters {
+		if domain != "dom-1" {
+			t.Errorf("%s: domain_id = %q, want dom-1", path, domain)
+		}
+	}
+}
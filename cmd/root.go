@@ -1,11 +1,17 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/mailersend/mailersend-cli/cmd/activity"
 	"github.com/mailersend/mailersend-cli/cmd/analytics"
 	"github.com/mailersend/mailersend-cli/cmd/auth"
 	"github.com/mailersend/mailersend-cli/cmd/bulkemail"
 	"github.com/mailersend/mailersend-cli/cmd/completion"
+	configcmd "github.com/mailersend/mailersend-cli/cmd/config"
 	"github.com/mailersend/mailersend-cli/cmd/dashboard"
 	"github.com/mailersend/mailersend-cli/cmd/domain"
 	"github.com/mailersend/mailersend-cli/cmd/email"
@@ -24,6 +30,12 @@ import (
 	"github.com/mailersend/mailersend-cli/cmd/verification"
 	"github.com/mailersend/mailersend-cli/cmd/webhook"
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-cli/internal/tui/theme"
+	"github.com/mailersend/mailersend-cli/internal/version"
 	"github.com/spf13/cobra"
 )
 
@@ -33,14 +45,128 @@ var rootCmd = &cobra.Command{
 	Long:          "A command-line interface for the MailerSend API. Send emails, manage domains, templates, webhooks, and more.",
 	SilenceUsage:  true,
 	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if cmdutil.TimeoutFlag(cmd) <= 0 {
+			return fmt.Errorf("--timeout must be positive, e.g. 30s or 2m")
+		}
+		sdkclient.SetSequential(cmdutil.SequentialFlag(cmd))
+		// Redirected first, so that color and JSON layout are chosen for
+		// the file rather than the terminal.
+		if path := cmdutil.OutputFileFlag(cmd); path != "" && path != "-" {
+			stop, err := output.StartOutputFile(path)
+			if err != nil {
+				return err
+			}
+			stopOutputFile = stop
+		}
+		config.SetPath(cmdutil.ConfigFlag(cmd))
+		config.SetTokenFile(cmdutil.TokenFileFlag(cmd))
+		client, err := cmdutil.NewHTTPClient(cmd)
+		if err != nil {
+			return err
+		}
+		auth.SetHTTPClient(client)
+		output.SetEnvelope(cmdutil.EnvelopeFlag(cmd))
+		output.SetQuiet(cmdutil.QuietFlag(cmd))
+		output.SetRelativeTime(cmdutil.RelativeTimeFlag(cmd))
+		if err := output.SetTimeFormat(cmdutil.TimeFormatFlag(cmd)); err != nil {
+			return err
+		}
+		if err := applyColorMode(cmd); err != nil {
+			return err
+		}
+		if err := applyConfig(cmd); err != nil {
+			return err
+		}
+		if err := applyOutputFormat(cmd); err != nil {
+			return err
+		}
+		if err := output.SetJSONPath(cmdutil.JSONPathFlag(cmd)); err != nil {
+			return err
+		}
+		if err := applyJSONLayout(cmd); err != nil {
+			return err
+		}
+		output.SetFields(cmdutil.FieldsFlag(cmd))
+		output.SetIDsOnly(cmdutil.IDsOnlyFlags(cmd))
+		if cmd.Name() == "list" && cmd.HasParent() && !cmdutil.JSONFlag(cmd) {
+			output.SetSummaryNoun(listNouns(cmd.Parent().Name()))
+		} else {
+			output.SetSummaryNoun("", "")
+		}
+		output.SetPageFooter("")
+		output.SetPageMeta(nil)
+		if cmdutil.PagerFlag(cmd) && output.IsTerminal(os.Stdout) {
+			stop, err := output.StartPager(output.PagerCommand())
+			if err != nil {
+				return err
+			}
+			stopPager = stop
+		}
+		// Started after the pager so that the pager receives stripped text.
+		if cmdutil.StripColorFlag(cmd) {
+			stop, err := output.StartStripColor()
+			if err != nil {
+				return err
+			}
+			stopStripColor = stop
+		}
+		return nil
+	},
+}
+
+// listNouns derives the singular and plural row nouns for a list command's
+// count line from its parent command, e.g. "domain" → "domain", "domains".
+// Names that are already plural or compound, like "hard-bounces" or
+// "on-hold", are used as is.
+func listNouns(name string) (string, string) {
+	switch {
+	case strings.HasSuffix(name, "s") || strings.Contains(name, "-"):
+		return name, name
+	case strings.HasSuffix(name, "y"):
+		return name, strings.TrimSuffix(name, "y") + "ies"
+	default:
+		return name, name + "s"
+	}
 }
 
+// stopPager flushes and closes the pager started by --pager, if any.
+var stopPager func()
+
+// stopStripColor flushes the --strip-color filter, if any.
+var stopStripColor func()
+
+// stopOutputFile closes the --output-file file, if any, removing it when
+// the command failed.
+var stopOutputFile func(failed bool) error
+
 func init() {
-	rootCmd.Version = version
-	cmdutil.SetVersion(version)
-	rootCmd.PersistentFlags().String("profile", "", "config profile to use")
+	rootCmd.Version = version.Version
+	rootCmd.PersistentFlags().String("profile", "", "config profile to use (default $MAILERSEND_PROFILE, then the active profile)")
+	rootCmd.PersistentFlags().String("config", "", "config file to use (default $MAILERSEND_CONFIG, then ~/.config/mailersend/config.yaml)")
+	rootCmd.PersistentFlags().String("token-file", "", "read the API token from this file (overrides $MAILERSEND_API_TOKEN_FILE, $MAILERSEND_API_TOKEN, and profiles)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
-	rootCmd.PersistentFlags().Bool("json", false, "output as JSON")
+	rootCmd.PersistentFlags().Bool("json", false, "output as JSON (same as --output json)")
+	rootCmd.PersistentFlags().StringP("output", "o", "table", "output format: "+strings.Join(output.Formats, ", "))
+	rootCmd.PersistentFlags().Bool("ids-only", false, "print only the ID column of tables, one per line")
+	rootCmd.PersistentFlags().Bool("print0", false, "like --ids-only, but separate IDs with NUL bytes for xargs -0")
+	rootCmd.PersistentFlags().StringSlice("fields", nil, "table columns to show, in order, e.g. name,id")
+	rootCmd.PersistentFlags().String("indent", "2", "spaces to indent JSON output with (0-8), or \"tab\"; piped JSON is compact unless this is set")
+	rootCmd.PersistentFlags().Bool("json-compact", false, "write JSON on a single line, even to a terminal")
+	rootCmd.PersistentFlags().String("jsonpath", "", "print the JSON values matching a path like $[*].id (a list) or $.data.id, one per line (implies --json)")
+	rootCmd.PersistentFlags().String("color", "auto", "when to color output: auto (off when NO_COLOR is set or stdout is not a terminal), always, or never")
+	rootCmd.PersistentFlags().Bool("no-color", false, "disable colors and styling (same as --color never)")
+	rootCmd.PersistentFlags().Bool("strip-color", false, "remove ANSI escape codes from output, whatever the color mode")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "suppress success and informational messages (errors and JSON output are still printed)")
+	rootCmd.PersistentFlags().Duration("timeout", cmdutil.DefaultTimeout, "HTTP request timeout, e.g. 90s or 2m")
+	rootCmd.PersistentFlags().String("proxy", "", "proxy URL for API requests, overriding HTTP_PROXY/HTTPS_PROXY")
+	rootCmd.PersistentFlags().Bool("sequential", false, "fetch list pages one at a time instead of several at once")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "resolve domain names through the API instead of the local domain cache")
+	rootCmd.PersistentFlags().String("output-file", "", "write output to this file instead of stdout, byte for byte")
+	rootCmd.PersistentFlags().Bool("pager", false, "page output through $MAILERSEND_PAGER, $PAGER, or less when stdout is a terminal")
+	rootCmd.PersistentFlags().Bool("relative-time", false, "show timestamps in tables as relative times, e.g. \"3 days ago\"")
+	rootCmd.PersistentFlags().String("time-format", "", "timestamp format in tables: rfc3339, local, unix, or a Go layout like \"2006-01-02 15:04\"")
+	rootCmd.PersistentFlags().Bool("envelope", false, "wrap JSON output in a uniform {\"data\": ..., \"meta\": {...}} envelope")
 
 	rootCmd.AddCommand(dashboard.Cmd)
 	rootCmd.AddCommand(email.Cmd)
@@ -53,6 +179,7 @@ func init() {
 	rootCmd.AddCommand(verification.Cmd)
 	rootCmd.AddCommand(auth.Cmd)
 	rootCmd.AddCommand(profile.Cmd)
+	rootCmd.AddCommand(configcmd.Cmd)
 	rootCmd.AddCommand(completion.Cmd)
 	rootCmd.AddCommand(recipient.Cmd)
 	rootCmd.AddCommand(identity.Cmd)
@@ -65,12 +192,117 @@ func init() {
 	rootCmd.AddCommand(bulkemail.Cmd)
 	rootCmd.AddCommand(sms.Cmd)
 	rootCmd.AddCommand(versionCmd)
+	cmdutil.RegisterDomainCompletion(rootCmd)
 }
 
 func Execute() error {
-	return rootCmd.Execute()
+	err := rootCmd.Execute()
+	if err == nil {
+		err = output.Err()
+	}
+	if stopStripColor != nil {
+		stopStripColor()
+		stopStripColor = nil
+	}
+	if stopPager != nil {
+		stopPager()
+		stopPager = nil
+	}
+	if stopOutputFile != nil {
+		if ferr := stopOutputFile(err != nil); err == nil {
+			err = ferr
+		}
+		stopOutputFile = nil
+	}
+	return err
 }
 
 func IsJSON() bool {
 	return cmdutil.JSONFlag(rootCmd)
 }
+
+// applyConfig applies the output settings from the config file: the theme
+// colors, and default_output unless --output or --json was given explicitly.
+// A config that cannot be read is left for the command itself to report; an
+// invalid color is an error.
+func applyConfig(cmd *cobra.Command) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil
+	}
+	if err := cfg.Theme.Validate(); err != nil {
+		return err
+	}
+	output.SetColors(cfg.Theme.Primary, cfg.Theme.Success, cfg.Theme.Error)
+	theme.Apply(cfg.Theme)
+	if cfg.DefaultOutput != "" {
+		flags := cmd.Root().PersistentFlags()
+		if !flags.Changed("output") && !flags.Changed("json") {
+			_ = flags.Set("output", cfg.DefaultOutput)
+			flags.Lookup("output").Changed = false
+		}
+	}
+	return nil
+}
+
+// applyColorMode resolves --color and --no-color into the output color mode.
+func applyColorMode(cmd *cobra.Command) error {
+	mode := cmdutil.ColorFlag(cmd)
+	if noColor, _ := cmd.Root().PersistentFlags().GetBool("no-color"); noColor {
+		if mode != "auto" && mode != "never" {
+			return fmt.Errorf("--no-color cannot be combined with --color %s", mode)
+		}
+		mode = "never"
+	}
+	return output.SetColorMode(mode)
+}
+
+// applyOutputFormat resolves --output, --json, and --jsonpath into the
+// output format. --json is an alias for --output json and --jsonpath
+// implies it, so either conflicts with any other explicit --output.
+func applyOutputFormat(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	format := cmdutil.OutputFlag(cmd)
+	jsonFlag, _ := flags.GetBool("json")
+	if jsonFlag || cmdutil.JSONPathFlag(cmd) != "" {
+		if flags.Changed("output") && format != "json" {
+			return fmt.Errorf("--json and --jsonpath cannot be combined with --output %s", format)
+		}
+		format = "json"
+	}
+	return output.SetFormat(format)
+}
+
+// applyJSONLayout resolves --indent and --json-compact into the JSON
+// indentation. JSON is indented for a terminal and compact when piped, unless
+// --indent is given explicitly.
+func applyJSONLayout(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	indent := cmdutil.IndentFlag(cmd)
+	if cmdutil.JSONCompactFlag(cmd) {
+		if flags.Changed("indent") {
+			return fmt.Errorf("--json-compact cannot be combined with --indent")
+		}
+		indent = "0"
+	} else if !flags.Changed("indent") && !output.IsTerminal(os.Stdout) {
+		indent = "0"
+	}
+	return output.SetIndent(indent)
+}
+
+// ReportError prints a command error and returns the process exit code.
+// Under --json, API errors are written as their raw JSON body, and under
+// --output yaml as the same body in YAML; otherwise the
+// message goes to stderr. A declined confirmation prints "Cancelled.". This
+// path is never silenced by --quiet.
+func ReportError(err error) int {
+	var cliErr *sdkclient.CLIError
+	if errors.Is(err, prompt.ErrCancelled) {
+		fmt.Fprintln(os.Stderr, "Cancelled.")
+	} else if errors.As(err, &cliErr) && IsJSON() && len(cliErr.RawBody) > 0 {
+		_ = output.JSONError(cliErr.RawBody)
+	} else {
+		output.Error(err.Error())
+	}
+	return 1
+}
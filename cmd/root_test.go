@@ -0,0 +1,374 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// captureStderr runs fn with os.Stderr redirected to a pipe and returns
+// everything written to it.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	origStderr := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stderr = w
+	defer func() { os.Stderr = origStderr }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+// captureStdout is captureStderr for os.Stdout.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+// resetGlobalFlags restores the named root flags to their defaults after a
+// test that runs Execute.
+func resetGlobalFlags(names ...string) {
+	for _, name := range names {
+		f := rootCmd.PersistentFlags().Lookup(name)
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	}
+	_ = output.SetFormat("table")
+}
+
+func TestReportError_QuietStillPrintsErrorAndFails(t *testing.T) {
+	// No token and an empty config dir make any API command fail before
+	// touching the network.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "")
+
+	rootCmd.SetArgs([]string{"--quiet", "domain", "get", "domain-id-1"})
+	defer rootCmd.SetArgs(nil)
+
+	var code int
+	stderr := captureStderr(t, func() {
+		err := Execute()
+		if err == nil {
+			t.Fatal("expected command to fail without credentials")
+		}
+		code = ReportError(err)
+	})
+
+	if code == 0 {
+		t.Fatal("expected non-zero exit code under --quiet")
+	}
+	if !strings.Contains(stderr, "no profiles configured") {
+		t.Fatalf("expected error text on stderr, got %q", stderr)
+	}
+}
+
+func TestReportError_Cancelled(t *testing.T) {
+	var code int
+	stderr := captureStderr(t, func() {
+		code = ReportError(fmt.Errorf("delete: %w", prompt.ErrCancelled))
+	})
+	if code == 0 {
+		t.Error("expected a non-zero exit code for a declined confirmation")
+	}
+	if stderr != "Cancelled.\n" {
+		t.Errorf("stderr = %q, want %q", stderr, "Cancelled.\n")
+	}
+}
+
+func TestReportError_YAMLOutput(t *testing.T) {
+	if err := rootCmd.PersistentFlags().Set("output", "yaml"); err != nil {
+		t.Fatal(err)
+	}
+	if err := output.SetFormat("yaml"); err != nil {
+		t.Fatal(err)
+	}
+	defer resetGlobalFlags("output")
+
+	err := sdkclient.ResponseError(422, []byte(`{"message":"The name field is required.","errors":{"name":["The name field is required."]}}`))
+	var code int
+	out := captureStdout(t, func() {
+		code = ReportError(err)
+	})
+	if code == 0 {
+		t.Error("expected a non-zero exit code")
+	}
+	want := "message: The name field is required.\nerrors:\n  name:\n    - The name field is required.\n"
+	if out != want {
+		t.Errorf("stdout = %q, want the error body as YAML %q", out, want)
+	}
+}
+
+func TestListNouns(t *testing.T) {
+	tests := []struct{ name, singular, plural string }{
+		{"domain", "domain", "domains"},
+		{"activity", "activity", "activities"},
+		{"hard-bounces", "hard-bounces", "hard-bounces"},
+	}
+	for _, tt := range tests {
+		singular, plural := listNouns(tt.name)
+		if singular != tt.singular || plural != tt.plural {
+			t.Errorf("listNouns(%q) = %q, %q; want %q, %q", tt.name, singular, plural, tt.singular, tt.plural)
+		}
+	}
+}
+
+func TestApplyOutputFormat(t *testing.T) {
+	flags := rootCmd.PersistentFlags()
+	reset := func() {
+		for _, name := range []string{"output", "json", "jsonpath"} {
+			f := flags.Lookup(name)
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		}
+	}
+	defer func() {
+		reset()
+		_ = output.SetFormat("table")
+	}()
+
+	tests := []struct {
+		args    []string
+		wantErr bool
+		json    bool
+	}{
+		{args: nil, json: false},
+		{args: []string{"--output", "csv"}, json: false},
+		{args: []string{"-o", "yaml"}, json: true},
+		{args: []string{"--json"}, json: true},
+		{args: []string{"--json", "-o", "json"}, json: true},
+		{args: []string{"--json", "-o", "csv"}, wantErr: true},
+		{args: []string{"-o", "xml"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		reset()
+		if err := flags.Parse(tt.args); err != nil {
+			t.Fatalf("parse %v: %v", tt.args, err)
+		}
+		err := applyOutputFormat(rootCmd)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%v: error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			continue
+		}
+		if err == nil && cmdutil.JSONFlag(rootCmd) != tt.json {
+			t.Errorf("%v: JSONFlag = %v, want %v", tt.args, !tt.json, tt.json)
+		}
+	}
+}
+
+func TestQuietShorthand(t *testing.T) {
+	if err := rootCmd.PersistentFlags().Parse([]string{"-q"}); err != nil {
+		t.Fatalf("parse -q: %v", err)
+	}
+	defer rootCmd.PersistentFlags().Set("quiet", "false") //nolint:errcheck
+	if !cmdutil.QuietFlag(rootCmd) {
+		t.Error("-q did not set --quiet")
+	}
+}
+
+// TestNoLocalFlagShadowsGlobal guards against a subcommand defining a flag
+// with the name of a global one, which Cobra resolves to the local flag.
+func TestNoLocalFlagShadowsGlobal(t *testing.T) {
+	// auth login --profile names the profile to save to, on purpose.
+	allowed := map[string]bool{"mailersend auth login --profile": true}
+	global := rootCmd.PersistentFlags()
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		c.LocalNonPersistentFlags().VisitAll(func(f *pflag.Flag) {
+			name := c.CommandPath() + " --" + f.Name
+			if global.Lookup(f.Name) != nil && !allowed[name] {
+				t.Errorf("%s shadows the global --%s flag", name, f.Name)
+			}
+		})
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+}
+
+func TestTokenFile_WithTokenCreateSaveTokenTo(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "env-token")
+	t.Setenv("MAILERSEND_API_TOKEN_FILE", "")
+
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"tok-2","accessToken":"mlsn.new-token","name":"ci","status":"unpause"}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "secret")
+	saved := filepath.Join(dir, "saved")
+	if err := os.WriteFile(secret, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	rootCmd.SetArgs([]string{"--token-file", secret, "--quiet", "token", "create",
+		"--name", "ci", "--domain", "dom-1", "--scopes", "email_full", "--save-token-to", saved})
+	defer rootCmd.SetArgs(nil)
+	defer func() {
+		for _, f := range []*pflag.Flag{rootCmd.PersistentFlags().Lookup("token-file"), rootCmd.PersistentFlags().Lookup("quiet")} {
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		}
+		config.SetTokenFile("")
+	}()
+	if err := Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if auth != "Bearer file-token" {
+		t.Errorf("Authorization = %q, want the token from --token-file", auth)
+	}
+	if data, _ := os.ReadFile(secret); string(data) != "file-token\n" {
+		t.Errorf("--token-file was modified: %q", data)
+	}
+	if data, _ := os.ReadFile(saved); string(data) != "mlsn.new-token" {
+		t.Errorf("--save-token-to file = %q, want the new token", data)
+	}
+}
+
+func TestAuthStatus_YAMLOutput(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_PROFILE", "")
+	if err := config.Save(&config.Config{
+		ActiveProfile: "work",
+		Profiles:      map[string]config.Profile{"work": {APIToken: "mlsn.abcdef123456"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	rootCmd.SetArgs([]string{"auth", "status", "-o", "yaml"})
+	defer rootCmd.SetArgs(nil)
+	defer resetGlobalFlags("output")
+	out := captureStdout(t, func() {
+		if err := Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "profile: work") || !strings.Contains(out, "method: token") {
+		t.Errorf("expected YAML status output, got:\n%s", out)
+	}
+}
+
+func TestOutputFile_WritesCommandOutput(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_PROFILE", "")
+	if err := config.Save(&config.Config{
+		ActiveProfile: "work",
+		Profiles:      map[string]config.Profile{"work": {APIToken: "mlsn.abcdef123456"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "status.json")
+
+	rootCmd.SetArgs([]string{"auth", "status", "--json", "--output-file", path})
+	defer rootCmd.SetArgs(nil)
+	defer resetGlobalFlags("json", "output-file")
+	out := captureStdout(t, func() {
+		if err := Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if out != "" {
+		t.Errorf("expected nothing on stdout, got %q", out)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"profile":"work"`) {
+		t.Errorf("output file = %s, want the compact status JSON", data)
+	}
+}
+
+func TestEnvelope_ListIncludesPageMeta(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"d11","name":"eleven.example.com"}],` + //nolint:errcheck
+			`"links":{"next":"https://api.mailersend.com/v1/domains?page=3"},` +
+			`"meta":{"current_page":2,"last_page":17,"per_page":"10","total":165}}`))
+	}))
+	defer server.Close()
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	list, _, _ := rootCmd.Find([]string{"domain", "list"})
+	defer func() {
+		for _, name := range []string{"page", "per-page"} {
+			f := list.Flags().Lookup(name)
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		}
+	}()
+	rootCmd.SetArgs([]string{"domain", "list", "--page", "2", "--per-page", "10", "--json", "--envelope"})
+	defer rootCmd.SetArgs(nil)
+	defer resetGlobalFlags("json", "envelope")
+	out := captureStdout(t, func() {
+		if err := Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var got struct {
+		Data []map[string]interface{} `json:"data"`
+		Meta map[string]float64       `json:"meta"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	if len(got.Data) != 1 {
+		t.Errorf("data = %v, want the one domain", got.Data)
+	}
+	want := map[string]float64{"count": 1, "page": 2, "per_page": 10, "last_page": 17, "total": 165}
+	for k, v := range want {
+		if got.Meta[k] != v {
+			t.Errorf("meta[%q] = %v, want %v (meta: %v)", k, got.Meta[k], v, got.Meta)
+		}
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func newRootCmd() *cobra.Command {
@@ -82,3 +84,83 @@ func TestSmsActivityList_NoSmsNumberId(t *testing.T) {
 		t.Errorf("expected /sms-activity, got %s", receivedPath)
 	}
 }
+
+func TestSmsActivityList_DateAndStatusQueryParams(t *testing.T) {
+	var receivedQuery url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedQuery = r.URL.Query()
+		resp := map[string]interface{}{
+			"data":  []interface{}{},
+			"links": map[string]string{"next": ""},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"sms", "activity", "list",
+		"--date-from", "2024-01-01", "--date-to", "1704153600", "--status", "failed,delivered"})
+	defer func() {
+		_ = activityListCmd.Flags().Set("date-from", "")
+		_ = activityListCmd.Flags().Set("date-to", "")
+		_ = activityListCmd.Flags().Lookup("status").Value.(pflag.SliceValue).Replace(nil)
+	}()
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if got := receivedQuery.Get("date_from"); got != "1704067200" {
+		t.Errorf("expected date_from=1704067200, got %q", got)
+	}
+	if got := receivedQuery.Get("date_to"); got != "1704153600" {
+		t.Errorf("expected date_to=1704153600, got %q", got)
+	}
+	if got := receivedQuery["status[]"]; strings.Join(got, ",") != "failed,delivered" {
+		t.Errorf("expected status[]=failed,delivered, got %v", got)
+	}
+}
+
+func TestSmsActivity_ListsWithoutSubcommand(t *testing.T) {
+	var receivedQuery url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sms-activity" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		receivedQuery = r.URL.Query()
+		resp := map[string]interface{}{
+			"data":  []interface{}{},
+			"links": map[string]string{"next": ""},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"sms", "activity", "--sms-number-id", "num-1", "--status", "failed"})
+	defer func() {
+		_ = activityCmd.Flags().Set("sms-number-id", "")
+		_ = activityCmd.Flags().Lookup("status").Value.(pflag.SliceValue).Replace(nil)
+	}()
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if got := receivedQuery.Get("sms_number_id"); got != "num-1" {
+		t.Errorf("expected sms_number_id=num-1, got %q", got)
+	}
+	if got := receivedQuery["status[]"]; strings.Join(got, ",") != "failed" {
+		t.Errorf("expected status[]=failed, got %v", got)
+	}
+}
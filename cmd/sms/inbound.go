@@ -24,6 +24,7 @@ func init() {
 	inboundCmd.AddCommand(inboundDeleteCmd)
 
 	inboundListCmd.Flags().Int("limit", 0, "maximum number of routes to return (0 = all)")
+	cmdutil.AddPageFlags(inboundListCmd)
 	inboundListCmd.Flags().String("sms-number-id", "", "filter by SMS number ID")
 	inboundListCmd.Flags().Bool("enabled", false, "filter by enabled status")
 
@@ -33,6 +34,7 @@ func init() {
 	inboundCreateCmd.Flags().String("filter-comparer", "", "filter comparer")
 	inboundCreateCmd.Flags().String("filter-value", "", "filter value")
 	inboundCreateCmd.Flags().Bool("enabled", true, "whether the route is enabled")
+	inboundCreateCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
 
 	inboundUpdateCmd.Flags().String("sms-number-id", "", "SMS number ID")
 	inboundUpdateCmd.Flags().String("name", "", "route name")
@@ -61,7 +63,7 @@ var inboundListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.SmsInbound, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.SmsInbound, bool, error) {
 			root, _, err := ms.SmsInbound.List(ctx, &mailersend.ListSmsInboundOptions{
 				SmsNumberId: smsNumberID,
 				Enabled:     enabled,
@@ -87,7 +89,7 @@ var inboundListCmd = &cobra.Command{
 			rows = append(rows, []string{r.Id, r.Name, boolYesNo(r.Enabled)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -120,7 +122,7 @@ var inboundGetCmd = &cobra.Command{
 			{"Forward URL", d.ForwardUrl},
 			{"Enabled", boolYesNo(d.Enabled)},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -178,6 +180,9 @@ var inboundCreateCmd = &cobra.Command{
 		}
 
 		output.Success("SMS inbound route created successfully. ID: " + result.Data.Id)
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(result.Data)
+		}
 		return nil
 	},
 }
@@ -252,6 +257,10 @@ var inboundDeleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("sms_inbound_route", args[0])
+		}
+
 		output.Success("SMS inbound route " + args[0] + " deleted successfully.")
 		return nil
 	},
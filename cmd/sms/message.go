@@ -2,7 +2,9 @@ package sms
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
@@ -12,8 +14,9 @@ import (
 )
 
 var messageCmd = &cobra.Command{
-	Use:   "message",
-	Short: "Manage SMS messages",
+	Use:     "message",
+	Aliases: []string{"messages"},
+	Short:   "Manage SMS messages",
 }
 
 func init() {
@@ -21,6 +24,10 @@ func init() {
 	messageCmd.AddCommand(messageGetCmd)
 
 	messageListCmd.Flags().Int("limit", 0, "maximum number of messages to return (0 = all)")
+	cmdutil.AddPageFlags(messageListCmd)
+	messageListCmd.Flags().String("date-from", "", "start date (YYYY-MM-DD or unix timestamp)")
+	messageListCmd.Flags().String("date-to", "", "end date (YYYY-MM-DD or unix timestamp)")
+	messageListCmd.Flags().StringSlice("status", nil, "filter by status (matches any SMS in the message)")
 }
 
 var messageListCmd = &cobra.Command{
@@ -33,9 +40,33 @@ var messageListCmd = &cobra.Command{
 		}
 
 		limit, _ := c.Flags().GetInt("limit")
+		statuses, _ := c.Flags().GetStringSlice("status")
+
+		var dateFrom, dateTo int64
+		if v, _ := c.Flags().GetString("date-from"); v != "" {
+			dateFrom, err = cmdutil.ParseDate(v)
+			if err != nil {
+				return err
+			}
+		}
+		if v, _ := c.Flags().GetString("date-to"); v != "" {
+			dateTo, err = cmdutil.ParseDate(v)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The SMS messages endpoint only supports page and limit, so date
+		// and status filters are applied client-side. That requires fetching
+		// all messages and trimming to --limit afterward.
+		filtering := dateFrom > 0 || dateTo > 0 || len(statuses) > 0
+		fetchLimit := limit
+		if filtering {
+			fetchLimit = 0
+		}
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.SmsMessageData, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.SmsMessageData, bool, error) {
 			root, _, err := ms.SmsMessage.List(ctx, &mailersend.ListSmsMessageOptions{
 				Page:  page,
 				Limit: perPage,
@@ -44,31 +75,98 @@ var messageListCmd = &cobra.Command{
 				return nil, false, sdkclient.WrapError(err)
 			}
 			return root.Data, root.Links.Next != "", nil
-		}, limit)
+		}, fetchLimit)
 		if err != nil {
 			return err
 		}
 
+		if filtering {
+			filtered := items[:0]
+			for _, m := range items {
+				if messageMatches(m, dateFrom, dateTo, statuses) {
+					filtered = append(filtered, m)
+				}
+			}
+			items = filtered
+			if limit > 0 && len(items) > limit {
+				items = items[:limit]
+			}
+		}
+
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
 		}
 
-		headers := []string{"ID", "FROM", "TO", "CREATED AT"}
+		headers := []string{"ID", "FROM", "TO", "STATUS", "ERROR", "CREATED AT"}
 		var rows [][]string
 		for _, m := range items {
-			createdAt := ""
-			if !m.CreatedAt.IsZero() {
-				createdAt = m.CreatedAt.Format("2006-01-02 15:04:05")
-			}
+			createdAt := output.FormatTime(m.CreatedAt, "2006-01-02 15:04:05")
 			toStr := strings.Join(m.To, ", ")
-			rows = append(rows, []string{m.Id, m.From, toStr, createdAt})
+			status, errText := messageStatus(m)
+			rows = append(rows, []string{m.Id, m.From, toStr, status, output.Truncate(errText, 40), createdAt})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
 
+// messageMatches reports whether m was created within [dateFrom, dateTo]
+// (unix timestamps, 0 = unbounded) and, if statuses is non-empty, has an SMS
+// in one of those statuses.
+func messageMatches(m mailersend.SmsMessageData, dateFrom, dateTo int64, statuses []string) bool {
+	if dateFrom > 0 && m.CreatedAt.Before(time.Unix(dateFrom, 0)) {
+		return false
+	}
+	if dateTo > 0 && m.CreatedAt.After(time.Unix(dateTo, 0)) {
+		return false
+	}
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, sms := range m.SmsMessage {
+		for _, s := range statuses {
+			if strings.EqualFold(sms.Status, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// messageStatus summarizes the distinct statuses of a message's SMS parts
+// and the first error description reported for any of them.
+func messageStatus(m mailersend.SmsMessageData) (string, string) {
+	var statuses []string
+	seen := map[string]bool{}
+	errText := ""
+	for _, sms := range m.SmsMessage {
+		if sms.Status != "" && !seen[sms.Status] {
+			seen[sms.Status] = true
+			statuses = append(statuses, sms.Status)
+		}
+		if errText == "" && sms.ErrorDescription != nil {
+			errText = fmt.Sprint(sms.ErrorDescription)
+		}
+	}
+	return strings.Join(statuses, ", "), errText
+}
+
+// recipientRows lists the delivery status of each SMS part as indented
+// FIELD/VALUE rows keyed by recipient number, with the segment count and
+// any error description.
+func recipientRows(m mailersend.SmsMessageData) [][]string {
+	var rows [][]string
+	for _, sms := range m.SmsMessage {
+		value := fmt.Sprintf("%s (segments: %d)", sms.Status, sms.SegmentCount)
+		if sms.ErrorDescription != nil {
+			value += ": " + fmt.Sprint(sms.ErrorDescription)
+		}
+		rows = append(rows, []string{"  " + sms.To, value})
+	}
+	return rows
+}
+
 var messageGetCmd = &cobra.Command{
 	Use:   "get <id>",
 	Short: "Get SMS message details",
@@ -92,7 +190,7 @@ var messageGetCmd = &cobra.Command{
 		d := result.Data
 		createdAt := ""
 		if !d.CreatedAt.IsZero() {
-			createdAt = d.CreatedAt.Format("2006-01-02 15:04:05")
+			createdAt = output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")
 		}
 		toStr := strings.Join(d.To, ", ")
 		headers := []string{"FIELD", "VALUE"}
@@ -103,7 +201,17 @@ var messageGetCmd = &cobra.Command{
 			{"Text", d.Text},
 			{"Created At", createdAt},
 		}
-		output.Table(headers, rows)
+		if status, errText := messageStatus(d); status != "" {
+			rows = append(rows, []string{"Status", status})
+			if errText != "" {
+				rows = append(rows, []string{"Error", errText})
+			}
+		}
+		if recipients := recipientRows(d); len(recipients) > 0 {
+			rows = append(rows, []string{"Recipients", ""})
+			rows = append(rows, recipients...)
+		}
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -0,0 +1,116 @@
+package sms
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/mailersend/mailersend-go"
+	"github.com/spf13/pflag"
+)
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return out
+}
+
+func TestSmsMessageList_DateAndStatusFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sms := func(status string, errDesc interface{}) []map[string]interface{} {
+			return []map[string]interface{}{{"id": "s", "status": status, "error_description": errDesc}}
+		}
+		resp := map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "m1", "created_at": "2024-01-01T10:00:00Z", "sms": sms("delivered", nil)},
+				{"id": "m2", "created_at": "2024-01-02T10:00:00Z", "sms": sms("failed", "Invalid number")},
+				{"id": "m3", "created_at": "2024-01-02T11:00:00Z", "sms": sms("delivered", nil)},
+				{"id": "m4", "created_at": "2024-01-03T10:00:00Z", "sms": sms("failed", nil)},
+			},
+			"links": map[string]string{"next": ""},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = messageListCmd.Flags().Set("date-from", "")
+		_ = messageListCmd.Flags().Set("date-to", "")
+		_ = messageListCmd.Flags().Lookup("status").Value.(pflag.SliceValue).Replace(nil)
+	}()
+
+	root := newRootCmd()
+	// 2024-01-02 00:00 to 2024-01-02 23:59:59 UTC, failed only.
+	root.SetArgs([]string{"sms", "message", "list",
+		"--date-from", "2024-01-02", "--date-to", "1704239999", "--status", "FAILED", "--json"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var items []struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(out, &items); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(items) != 1 || items[0].ID != "m2" {
+		t.Errorf("expected only m2, got %+v", items)
+	}
+}
+
+func TestMessageStatus_StatusAndError(t *testing.T) {
+	m := mailersend.SmsMessageData{SmsMessage: []mailersend.SmsMessage{
+		{Status: "sent"},
+		{Status: "failed", ErrorDescription: "Invalid number"},
+		{Status: "failed"},
+	}}
+	status, errText := messageStatus(m)
+	if status != "sent, failed" {
+		t.Errorf("expected status %q, got %q", "sent, failed", status)
+	}
+	if errText != "Invalid number" {
+		t.Errorf("expected error %q, got %q", "Invalid number", errText)
+	}
+}
+
+func TestRecipientRows_PerRecipientStatus(t *testing.T) {
+	m := mailersend.SmsMessageData{SmsMessage: []mailersend.SmsMessage{
+		{To: "+15550001", Status: "delivered", SegmentCount: 1},
+		{To: "+15550002", Status: "failed", SegmentCount: 2, ErrorDescription: "Invalid number"},
+	}}
+	rows := recipientRows(m)
+	if len(rows) != 2 {
+		t.Fatalf("expected one row per recipient, got %v", rows)
+	}
+	if rows[0][0] != "  +15550001" || rows[0][1] != "delivered (segments: 1)" {
+		t.Errorf("unexpected first row: %v", rows[0])
+	}
+	if rows[1][1] != "failed (segments: 2): Invalid number" {
+		t.Errorf("unexpected second row: %v", rows[1])
+	}
+}
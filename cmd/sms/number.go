@@ -11,8 +11,10 @@ import (
 )
 
 var numberCmd = &cobra.Command{
-	Use:   "number",
-	Short: "Manage SMS phone numbers",
+	Use:     "number",
+	Aliases: []string{"numbers"},
+	Short:   "Manage SMS phone numbers",
+	Long:    "List, view, update, and delete SMS phone numbers. Their IDs are the --sms-number-id used by other sms commands.",
 }
 
 func init() {
@@ -22,6 +24,7 @@ func init() {
 	numberCmd.AddCommand(numberDeleteCmd)
 
 	numberListCmd.Flags().Int("limit", 0, "maximum number of numbers to return (0 = all)")
+	cmdutil.AddPageFlags(numberListCmd)
 	numberListCmd.Flags().Bool("paused", false, "filter by paused status")
 
 	numberUpdateCmd.Flags().Bool("paused", false, "whether the number is paused")
@@ -44,7 +47,7 @@ var numberListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Number, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.Number, bool, error) {
 			root, _, err := ms.SmsNumber.List(ctx, &mailersend.SmsNumberOptions{
 				Paused: paused,
 				Page:   page,
@@ -66,14 +69,11 @@ var numberListCmd = &cobra.Command{
 		headers := []string{"ID", "NUMBER", "PAUSED", "CREATED AT"}
 		var rows [][]string
 		for _, n := range items {
-			createdAt := ""
-			if !n.CreatedAt.IsZero() {
-				createdAt = n.CreatedAt.Format("2006-01-02 15:04:05")
-			}
+			createdAt := output.FormatTime(n.CreatedAt, "2006-01-02 15:04:05")
 			rows = append(rows, []string{n.Id, n.TelephoneNumber, boolYesNo(n.Paused), createdAt})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -101,7 +101,7 @@ var numberGetCmd = &cobra.Command{
 		d := result.Data
 		createdAt := ""
 		if !d.CreatedAt.IsZero() {
-			createdAt = d.CreatedAt.Format("2006-01-02 15:04:05")
+			createdAt = output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")
 		}
 		headers := []string{"FIELD", "VALUE"}
 		rows := [][]string{
@@ -110,7 +110,7 @@ var numberGetCmd = &cobra.Command{
 			{"Paused", boolYesNo(d.Paused)},
 			{"Created At", createdAt},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -165,6 +165,10 @@ var numberDeleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("sms_number", args[0])
+		}
+
 		output.Success("SMS number " + args[0] + " deleted successfully.")
 		return nil
 	},
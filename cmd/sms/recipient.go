@@ -22,6 +22,7 @@ func init() {
 	recipientCmd.AddCommand(recipientUpdateCmd)
 
 	recipientListCmd.Flags().Int("limit", 0, "maximum number of recipients to return (0 = all)")
+	cmdutil.AddPageFlags(recipientListCmd)
 	recipientListCmd.Flags().String("status", "", "filter by status")
 	recipientListCmd.Flags().String("sms-number-id", "", "filter by SMS number ID")
 
@@ -43,7 +44,7 @@ var recipientListCmd = &cobra.Command{
 		// The SDK expects Status as bool, but the old code used string.
 		// We pass the sms-number-id and let the API handle status filtering.
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.SmsRecipient, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.SmsRecipient, bool, error) {
 			opts := &mailersend.SmsRecipientOptions{
 				SmsNumberId: smsNumberID,
 				Page:        page,
@@ -66,14 +67,11 @@ var recipientListCmd = &cobra.Command{
 		headers := []string{"ID", "NUMBER", "STATUS", "CREATED AT"}
 		var rows [][]string
 		for _, r := range items {
-			createdAt := ""
-			if !r.CreatedAt.IsZero() {
-				createdAt = r.CreatedAt.Format("2006-01-02 15:04:05")
-			}
+			createdAt := output.FormatTime(r.CreatedAt, "2006-01-02 15:04:05")
 			rows = append(rows, []string{r.Id, r.Number, r.Status, createdAt})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -105,7 +103,7 @@ var recipientGetCmd = &cobra.Command{
 			{"Number", d.Number},
 			{"Status", d.Status},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
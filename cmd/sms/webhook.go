@@ -31,6 +31,7 @@ func init() {
 	webhookCreateCmd.Flags().String("url", "", "webhook URL (required)")
 	webhookCreateCmd.Flags().StringSlice("events", nil, "webhook events (required)")
 	webhookCreateCmd.Flags().Bool("enabled", true, "whether the webhook is enabled")
+	webhookCreateCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
 
 	webhookUpdateCmd.Flags().String("name", "", "webhook name")
 	webhookUpdateCmd.Flags().String("url", "", "webhook URL")
@@ -71,7 +72,7 @@ var webhookListCmd = &cobra.Command{
 			rows = append(rows, []string{w.Id, w.Name, output.Truncate(w.Url, 50), boolYesNo(w.Enabled)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -104,7 +105,7 @@ var webhookGetCmd = &cobra.Command{
 			{"URL", d.Url},
 			{"Enabled", boolYesNo(d.Enabled)},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 
 		if len(d.Events) > 0 {
 			fmt.Println("\nEvents:")
@@ -164,6 +165,9 @@ var webhookCreateCmd = &cobra.Command{
 		}
 
 		output.Success("SMS webhook created successfully. ID: " + result.Data.Id)
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(result.Data)
+		}
 		return nil
 	},
 }
@@ -230,6 +234,10 @@ var webhookDeleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("sms_webhook", args[0])
+		}
+
 		output.Success("SMS webhook " + args[0] + " deleted successfully.")
 		return nil
 	},
@@ -24,20 +24,22 @@ func init() {
 	Cmd.AddCommand(updateCmd)
 	Cmd.AddCommand(deleteCmd)
 
-	listCmd.Flags().String("domain", "", "domain name or ID (required)")
+	listCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	listCmd.Flags().Int("limit", 0, "maximum number of SMTP users to return (0 = all)")
+	cmdutil.AddPageFlags(listCmd)
 
-	getCmd.Flags().String("domain", "", "domain name or ID (required)")
+	getCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 
-	createCmd.Flags().String("domain", "", "domain name or ID (required)")
+	createCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	createCmd.Flags().String("name", "", "SMTP user name (required)")
 	createCmd.Flags().Bool("enabled", true, "whether the SMTP user is enabled")
+	createCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
 
-	updateCmd.Flags().String("domain", "", "domain name or ID (required)")
+	updateCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	updateCmd.Flags().String("name", "", "SMTP user name")
 	updateCmd.Flags().Bool("enabled", true, "whether the SMTP user is enabled")
 
-	deleteCmd.Flags().String("domain", "", "domain name or ID (required)")
+	deleteCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 }
 
 func boolYesNo(b bool) string {
@@ -56,7 +58,7 @@ var listCmd = &cobra.Command{
 			return err
 		}
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -68,7 +70,7 @@ var listCmd = &cobra.Command{
 		limit, _ := c.Flags().GetInt("limit")
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.SmtpUser, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.SmtpUser, bool, error) {
 			root, _, err := ms.SmtpUser.List(ctx, domainID, &mailersend.ListSmtpUserOptions{
 				Page:  page,
 				Limit: perPage,
@@ -92,7 +94,7 @@ var listCmd = &cobra.Command{
 			rows = append(rows, []string{s.ID, s.Name, boolYesNo(s.Enabled)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -107,7 +109,7 @@ var getCmd = &cobra.Command{
 			return err
 		}
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -134,7 +136,7 @@ var getCmd = &cobra.Command{
 			{"Name", d.Name},
 			{"Enabled", boolYesNo(d.Enabled)},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -148,7 +150,7 @@ var createCmd = &cobra.Command{
 			return err
 		}
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -182,6 +184,9 @@ var createCmd = &cobra.Command{
 		}
 
 		output.Success("SMTP user created successfully. ID: " + result.Data.ID)
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(result.Data)
+		}
 		return nil
 	},
 }
@@ -196,7 +201,7 @@ var updateCmd = &cobra.Command{
 			return err
 		}
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -242,7 +247,7 @@ var deleteCmd = &cobra.Command{
 			return err
 		}
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -258,6 +263,10 @@ var deleteCmd = &cobra.Command{
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("smtp_user", args[0])
+		}
+
 		output.Success("SMTP user " + args[0] + " deleted successfully.")
 		return nil
 	},
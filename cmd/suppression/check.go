@@ -0,0 +1,65 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
+	"github.com/spf13/cobra"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check <email>",
+	Short: "Show which suppression lists contain an address",
+	Long: `Check the blocklist, hard bounces, spam complaints, unsubscribes, and
+on-hold list for an address. Blocklist patterns such as *@example.com count
+as matches. Each list is fetched in full, so this can take a while on large
+accounts; --domain narrows the lookup to one domain.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		ms, err := cmdutil.NewSDKClient(c)
+		if err != nil {
+			return err
+		}
+
+		email := args[0]
+		domainID := cmdutil.DomainFlag(c)
+		if domainID != "" {
+			domainID, err = cmdutil.ResolveDomainSDK(ms, domainID)
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx := context.Background()
+		matches, err := suppressions.Check(ctx, ms, email, domainID)
+		if err != nil {
+			return err
+		}
+
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(matches)
+		}
+
+		if len(matches) == 0 {
+			output.Success(fmt.Sprintf("%s is not on any suppression list.", email))
+			return nil
+		}
+
+		headers := []string{"LIST", "ID", "PATTERN/EMAIL", "CREATED AT"}
+		var rows [][]string
+		for _, m := range matches {
+			rows = append(rows, []string{m.List, m.ID, m.PatternEmail, output.FormatTimeString(m.CreatedAt)})
+		}
+		output.Render(headers, rows)
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(checkCmd)
+
+	checkCmd.Flags().String("domain", "", "only check this domain name or ID (defaults to the profile's default domain)")
+}
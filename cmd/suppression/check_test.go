@@ -0,0 +1,69 @@
+package suppression
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
+)
+
+func TestCheckCmd_ReportsMatchingLists(t *testing.T) {
+	var domainFilters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		domainFilters = append(domainFilters, r.URL.Query().Get("domain_id"))
+		var data []map[string]interface{}
+		switch r.URL.Path {
+		case "/suppressions/blocklist":
+			data = []map[string]interface{}{
+				{"id": "bl-1", "type": "pattern", "pattern": "*@example.com"},
+				{"id": "bl-2", "type": "pattern", "pattern": "*@other.com"},
+			}
+		case "/suppressions/unsubscribes":
+			data = []map[string]interface{}{{"id": "un-1", "recipient": map[string]string{"email": "Ann@Example.com"}}}
+		case "/suppressions/hard-bounces", "/suppressions/spam-complaints", "/suppressions/on-hold-list":
+			data = []map[string]interface{}{{"id": "x-1", "recipient": map[string]string{"email": "bob@example.com"}}}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data":  data,
+			"links": map[string]string{"next": ""},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"suppression", "check", "ann@example.com", "--domain", "dom-1"})
+	defer checkCmd.Flags().Set("domain", "") //nolint:errcheck
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var matches []suppressions.Match
+	if err := json.Unmarshal([]byte(out), &matches); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(matches) != 2 || matches[0].ID != "bl-1" || matches[1].List != "unsubscribes" {
+		t.Errorf("matches = %+v, want the blocklist pattern and the unsubscribe", matches)
+	}
+	if len(domainFilters) != len(suppressions.Types) {
+		t.Errorf("made %d requests, want one per list", len(domainFilters))
+	}
+	for _, d := range domainFilters {
+		if d != "dom-1" {
+			t.Errorf("domain_id = %q, want dom-1", d)
+		}
+	}
+}
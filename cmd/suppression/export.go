@@ -0,0 +1,116 @@
+package suppression
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export suppression lists to CSV",
+	Long: `Export every entry of a suppression list as CSV with the columns
+id, type, pattern_email, and created_at.
+
+With --type all, each list is written to its own file, named after --out
+with the type appended (suppressions.csv becomes suppressions-blocklist.csv,
+and so on).`,
+	RunE: func(c *cobra.Command, args []string) error {
+		ms, err := cmdutil.NewSDKClient(c)
+		if err != nil {
+			return err
+		}
+
+		listType, _ := c.Flags().GetString("type")
+		listType, err = prompt.RequireArg(listType, "type", "Suppression type")
+		if err != nil {
+			return err
+		}
+		out, _ := c.Flags().GetString("out")
+
+		if !slices.Contains(suppressions.Types, listType) && listType != "all" {
+			return fmt.Errorf("invalid --type %q: use %s, or all", listType, strings.Join(suppressions.Types, ", "))
+		}
+		types := []string{listType}
+		if listType == "all" {
+			types = suppressions.Types
+			if out == "" || out == "-" {
+				out = "suppressions.csv"
+			}
+		}
+
+		domainID, _ := c.Flags().GetString("domain")
+		if domainID != "" {
+			domainID, err = cmdutil.ResolveDomainSDK(ms, domainID)
+			if err != nil {
+				return err
+			}
+		}
+
+		ctx := context.Background()
+		for _, t := range types {
+			items, err := suppressions.Fetch(ctx, ms, t, domainID)
+			if err != nil {
+				return err
+			}
+
+			path := out
+			if listType == "all" {
+				path = exportPath(out, t)
+			}
+			if err := writeSuppressionCSV(path, t, items); err != nil {
+				return err
+			}
+			if path != "" && path != "-" {
+				output.Note(fmt.Sprintf("Wrote %d %s entries to %s", len(items), t, path))
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	Cmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().String("type", "", "suppression list to export: "+strings.Join(suppressions.Types, ", ")+", or all (required)")
+	exportCmd.Flags().String("domain", "", "only export entries for this domain name or ID")
+	exportCmd.Flags().String("out", "", "file to write (default stdout; with --type all, the base name of each file)")
+}
+
+// exportPath names the file for one list of a --type all export by
+// inserting the list type before out's extension.
+func exportPath(out, listType string) string {
+	ext := filepath.Ext(out)
+	if ext == "" {
+		ext = ".csv"
+	}
+	return strings.TrimSuffix(out, filepath.Ext(out)) + "-" + listType + ext
+}
+
+// writeSuppressionCSV writes items as CSV to path, or stdout when path is
+// "" or "-". Entries without a type of their own get the list type.
+func writeSuppressionCSV(path, listType string, items []suppressions.Item) error {
+	rows := make([][]string, 0, len(items))
+	for _, i := range items {
+		t := i.Type
+		if t == "" {
+			t = listType
+		}
+		rows = append(rows, []string{i.ID, t, i.PatternEmail, i.CreatedAt})
+	}
+
+	var buf bytes.Buffer
+	if err := output.WriteCSV(&buf, []string{"id", "type", "pattern_email", "created_at"}, rows); err != nil {
+		return err
+	}
+	return output.Raw(&buf, path)
+}
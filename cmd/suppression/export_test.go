@@ -0,0 +1,60 @@
+package suppression
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExportCmd_AllWritesOneFilePerType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]interface{}
+		switch r.URL.Path {
+		case "/suppressions/blocklist":
+			data = []map[string]interface{}{{"id": "bl-1", "type": "pattern", "pattern": "*@spam.example", "created_at": "2024-01-01T00:00:00Z"}}
+		case "/suppressions/hard-bounces":
+			data = []map[string]interface{}{{"id": "hb-1", "recipient": map[string]string{"email": "gone@example.com"}, "created_at": "2024-01-02T00:00:00Z"}}
+		case "/suppressions/spam-complaints", "/suppressions/unsubscribes", "/suppressions/on-hold-list":
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data":  data,
+			"links": map[string]string{"next": ""},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	dir := t.TempDir()
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "export", "--type", "all", "--out", filepath.Join(dir, "suppressions.csv")})
+	defer exportCmd.Flags().Set("type", "") //nolint:errcheck
+	defer exportCmd.Flags().Set("out", "")  //nolint:errcheck
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	for _, tc := range []struct{ file, want string }{
+		{"suppressions-blocklist.csv", "id,type,pattern_email,created_at\nbl-1,pattern,*@spam.example,2024-01-01 00:00:00\n"},
+		{"suppressions-hard-bounces.csv", "id,type,pattern_email,created_at\nhb-1,hard-bounces,gone@example.com,2024-01-02 00:00:00\n"},
+		{"suppressions-on-hold.csv", "id,type,pattern_email,created_at\n"},
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, tc.file))
+		if err != nil {
+			t.Errorf("%s not written: %v", tc.file, err)
+			continue
+		}
+		if got := strings.ReplaceAll(string(data), "\r\n", "\n"); got != tc.want {
+			t.Errorf("%s = %q, want %q", tc.file, got, tc.want)
+		}
+	}
+}
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strings"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/prompt"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
 	"github.com/mailersend/mailersend-go"
 	"github.com/spf13/cobra"
 )
@@ -32,23 +35,156 @@ func init() {
 
 // --- helpers ---
 
-// suppressionItem is a generic representation for table display across all suppression types.
-type suppressionItem struct {
-	ID           string
-	Type         string
-	PatternEmail string
-	CreatedAt    string
-}
-
 func addListFlags(cmd *cobra.Command) {
 	cmd.Flags().Int("limit", 0, "maximum number of items to return (0 = all)")
 	cmd.Flags().String("domain", "", "filter by domain name or ID")
+	cmd.Flags().String("search", "", "only show entries whose email or pattern contains this text")
+	cmdutil.AddPageFlags(cmd)
+}
+
+// searchFlags returns --limit, the limit to fetch with, and --search. The
+// API has no search filter, so --search matches client-side and needs every
+// entry fetched for --limit to count matches.
+func searchFlags(c *cobra.Command) (limit, fetchLimit int, search string) {
+	limit, _ = c.Flags().GetInt("limit")
+	search, _ = c.Flags().GetString("search")
+	if search != "" {
+		return limit, 0, search
+	}
+	return limit, limit, ""
+}
+
+// matchesSearch reports whether value contains search, ignoring case.
+func matchesSearch(value, search string) bool {
+	return strings.Contains(strings.ToLower(value), strings.ToLower(search))
+}
+
+// searchItems keeps the items matching search, up to limit (0 = all).
+func searchItems(items []suppressions.Item, search string, limit int) []suppressions.Item {
+	if search == "" {
+		return items
+	}
+	var kept []suppressions.Item
+	for _, i := range items {
+		if matchesSearch(i.PatternEmail, search) {
+			kept = append(kept, i)
+		}
+	}
+	if limit > 0 && len(kept) > limit {
+		kept = kept[:limit]
+	}
+	return kept
+}
+
+// searchOnHold is searchItems for on-hold entries.
+func searchOnHold(items []sdkclient.OnHoldEntry, search string, limit int) []sdkclient.OnHoldEntry {
+	if search == "" {
+		return items
+	}
+	var kept []sdkclient.OnHoldEntry
+	for _, i := range items {
+		if matchesSearch(i.Pattern, search) || matchesSearch(i.Recipient.Email, search) {
+			kept = append(kept, i)
+		}
+	}
+	if limit > 0 && len(kept) > limit {
+		kept = kept[:limit]
+	}
+	return kept
+}
+
+// blockWithComment is a blocklist create payload with the optional comment
+// recorded alongside the entries.
+type blockWithComment struct {
+	*mailersend.CreateSuppressionBlockOptions
+	Comment string `json:"comment"`
+}
+
+// addCommentFlag registers --comment on an add command. Only the blocklist
+// stores comments; for other types the flag is accepted and ignored with a
+// note so that scripts can pass it uniformly.
+func addCommentFlag(cmd *cobra.Command) {
+	cmd.Flags().String("comment", "", "note on why the entries were suppressed (stored for blocklist entries)")
+}
+
+// noteIgnoredComment tells the user that --comment has no effect for kind.
+func noteIgnoredComment(c *cobra.Command, kind string) {
+	if comment, _ := c.Flags().GetString("comment"); comment != "" {
+		output.Note("--comment is not supported for " + kind + " and was ignored")
+	}
+}
+
+// addFileFlag registers --file on an add command.
+func addFileFlag(cmd *cobra.Command) {
+	cmd.Flags().String("file", "", "read entries from this file, one per line (# starts a comment)")
+}
+
+// readEntryFile reads the --file entries, skipping blank and comment lines.
+func readEntryFile(c *cobra.Command) ([]string, error) {
+	path, _ := c.Flags().GetString("file")
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read entries file: %w", err)
+	}
+	var entries []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	return entries, nil
+}
+
+// addRecipients returns --recipients merged with the --file entries.
+func addRecipients(c *cobra.Command) ([]string, error) {
+	recipients, _ := c.Flags().GetStringSlice("recipients")
+	fromFile, err := readEntryFile(c)
+	if err != nil {
+		return nil, err
+	}
+	recipients = append(recipients, fromFile...)
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("provide --recipients or --file")
+	}
+	return recipients, nil
+}
+
+// addedEntries is the --json output of an add that may span several
+// requests: the created entries of every batch.
+type addedEntries[T any] struct {
+	Data []T `json:"data"`
+}
+
+// blockEntry is a blocklist entry to add: an address or a pattern.
+type blockEntry struct {
+	value   string
+	pattern bool
+}
+
+// isBlockPattern reports whether a blocklist file entry is a pattern rather
+// than a single address.
+func isBlockPattern(entry string) bool {
+	return strings.ContainsAny(entry, "*?") || !strings.Contains(entry, "@")
 }
 
 func addDeleteFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice("ids", nil, "IDs to delete")
 	cmd.Flags().Bool("all", false, "delete all entries")
 	cmd.Flags().String("domain", "", "domain name or ID")
+	cmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt for --all")
+}
+
+// deleteAllLabel is the confirmation prompt for deleting a whole list.
+func deleteAllLabel(list, domainID string) string {
+	if domainID == "" {
+		return fmt.Sprintf("Delete ALL %s entries for every domain?", list)
+	}
+	return fmt.Sprintf("Delete ALL %s entries for domain %s?", list, domainID)
 }
 
 func suppressionDeleteRun(suppressionType string) func(*cobra.Command, []string) error {
@@ -75,6 +211,13 @@ func suppressionDeleteRun(suppressionType string) func(*cobra.Command, []string)
 			}
 		}
 
+		if all {
+			yes, _ := c.Flags().GetBool("yes")
+			if err := prompt.ConfirmDestructive(yes, deleteAllLabel(suppressionType, domainID)); err != nil {
+				return err
+			}
+		}
+
 		if all {
 			_, err = ms.Suppression.DeleteAll(ctx, domainID, suppressionType)
 		} else {
@@ -87,6 +230,14 @@ func suppressionDeleteRun(suppressionType string) func(*cobra.Command, []string)
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			id := strings.Join(ids, ",")
+			if all {
+				id = "all"
+			}
+			return output.Deleted(strings.ReplaceAll(suppressionType, "-", "_"), id)
+		}
+
 		output.Success("Suppression entries deleted successfully.")
 		return nil
 	}
@@ -109,7 +260,7 @@ var blocklistListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		limit, _ := c.Flags().GetInt("limit")
+		limit, fetchLimit, search := searchFlags(c)
 
 		domainID, _ := c.Flags().GetString("domain")
 		if domainID != "" {
@@ -119,29 +270,11 @@ var blocklistListCmd = &cobra.Command{
 			}
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]suppressionItem, bool, error) {
-			root, _, err := ms.Suppression.ListBlockList(ctx, &mailersend.SuppressionOptions{
-				DomainID: domainID,
-				Page:     page,
-				Limit:    perPage,
-			})
-			if err != nil {
-				return nil, false, sdkclient.WrapError(err)
-			}
-			var out []suppressionItem
-			for _, d := range root.Data {
-				out = append(out, suppressionItem{
-					ID:           d.ID,
-					Type:         d.Type,
-					PatternEmail: d.Pattern,
-					CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
-				})
-			}
-			return out, root.Next != "", nil
-		}, limit)
+		items, err := cmdutil.FetchList(ctx, c, suppressions.BlocklistPages(ms, domainID), fetchLimit)
 		if err != nil {
 			return err
 		}
+		items = searchItems(items, search, limit)
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
@@ -150,10 +283,10 @@ var blocklistListCmd = &cobra.Command{
 		headers := []string{"ID", "TYPE", "PATTERN/EMAIL", "CREATED AT"}
 		var rows [][]string
 		for _, i := range items {
-			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, i.CreatedAt})
+			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, output.FormatTimeString(i.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -169,7 +302,7 @@ var blocklistAddCmd = &cobra.Command{
 
 		ctx := context.Background()
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -180,21 +313,61 @@ var blocklistAddCmd = &cobra.Command{
 		}
 		recipients, _ := c.Flags().GetStringSlice("recipients")
 		patterns, _ := c.Flags().GetStringSlice("patterns")
+		fromFile, err := readEntryFile(c)
+		if err != nil {
+			return err
+		}
+		for _, entry := range fromFile {
+			if isBlockPattern(entry) {
+				patterns = append(patterns, entry)
+			} else {
+				recipients = append(recipients, entry)
+			}
+		}
+		if len(recipients) == 0 && len(patterns) == 0 {
+			return fmt.Errorf("provide --recipients, --patterns, or --file")
+		}
 
-		result, _, err := ms.Suppression.CreateBlock(ctx, &mailersend.CreateSuppressionBlockOptions{
-			DomainID:   domainID,
-			Recipients: recipients,
-			Patterns:   patterns,
+		var entries []blockEntry
+		for _, r := range recipients {
+			entries = append(entries, blockEntry{value: r})
+		}
+		for _, p := range patterns {
+			entries = append(entries, blockEntry{value: p, pattern: true})
+		}
+		comment, _ := c.Flags().GetString("comment")
+		created, err := suppressions.AddInBatches(entries, func(batch []blockEntry) ([]mailersend.SuppressionBlockData, error) {
+			opts := &mailersend.CreateSuppressionBlockOptions{DomainID: domainID}
+			for _, e := range batch {
+				if e.pattern {
+					opts.Patterns = append(opts.Patterns, e.value)
+				} else {
+					opts.Recipients = append(opts.Recipients, e.value)
+				}
+			}
+			if comment != "" {
+				// The SDK's options have no comment field, so send the payload raw.
+				result := new(mailersend.SuppressionBlockResponse)
+				if err := sdkclient.PostJSON(ctx, ms, "/suppressions/blocklist", blockWithComment{opts, comment}, result); err != nil {
+					return nil, err
+				}
+				return result.Data, nil
+			}
+			result, _, err := ms.Suppression.CreateBlock(ctx, opts)
+			if err != nil {
+				return nil, sdkclient.WrapError(err)
+			}
+			return result.Data, nil
 		})
 		if err != nil {
-			return sdkclient.WrapError(err)
+			return err
 		}
 
 		if cmdutil.JSONFlag(c) {
-			return output.JSON(result)
+			return output.JSON(addedEntries[mailersend.SuppressionBlockData]{created})
 		}
 
-		output.Success("Blocklist entries added successfully.")
+		output.Success(fmt.Sprintf("Added %d blocklist entries.", len(created)))
 		return nil
 	},
 }
@@ -212,9 +385,11 @@ func init() {
 
 	addListFlags(blocklistListCmd)
 
-	blocklistAddCmd.Flags().String("domain", "", "domain name or ID (required)")
+	blocklistAddCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	blocklistAddCmd.Flags().StringSlice("recipients", nil, "recipient emails to block")
 	blocklistAddCmd.Flags().StringSlice("patterns", nil, "patterns to block")
+	addCommentFlag(blocklistAddCmd)
+	addFileFlag(blocklistAddCmd)
 
 	addDeleteFlags(blocklistDeleteCmd)
 }
@@ -236,7 +411,7 @@ var hardBouncesListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		limit, _ := c.Flags().GetInt("limit")
+		limit, fetchLimit, search := searchFlags(c)
 
 		domainID, _ := c.Flags().GetString("domain")
 		if domainID != "" {
@@ -246,28 +421,11 @@ var hardBouncesListCmd = &cobra.Command{
 			}
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]suppressionItem, bool, error) {
-			root, _, err := ms.Suppression.ListHardBounces(ctx, &mailersend.SuppressionOptions{
-				DomainID: domainID,
-				Page:     page,
-				Limit:    perPage,
-			})
-			if err != nil {
-				return nil, false, sdkclient.WrapError(err)
-			}
-			var out []suppressionItem
-			for _, d := range root.Data {
-				out = append(out, suppressionItem{
-					ID:           d.ID,
-					PatternEmail: d.Recipient.Email,
-					CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
-				})
-			}
-			return out, root.Next != "", nil
-		}, limit)
+		items, err := cmdutil.FetchList(ctx, c, suppressions.HardBouncesPages(ms, domainID), fetchLimit)
 		if err != nil {
 			return err
 		}
+		items = searchItems(items, search, limit)
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
@@ -276,10 +434,10 @@ var hardBouncesListCmd = &cobra.Command{
 		headers := []string{"ID", "TYPE", "PATTERN/EMAIL", "CREATED AT"}
 		var rows [][]string
 		for _, i := range items {
-			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, i.CreatedAt})
+			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, output.FormatTimeString(i.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -295,7 +453,7 @@ var hardBouncesAddCmd = &cobra.Command{
 
 		ctx := context.Background()
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -304,21 +462,32 @@ var hardBouncesAddCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		recipients, _ := c.Flags().GetStringSlice("recipients")
+		recipients, err := addRecipients(c)
+		if err != nil {
+			return err
+		}
 
-		result, _, err := ms.Suppression.CreateHardBounce(ctx, &mailersend.CreateSuppressionOptions{
-			DomainID:   domainID,
-			Recipients: recipients,
+		noteIgnoredComment(c, "hard bounces")
+
+		created, err := suppressions.AddInBatches(recipients, func(batch []string) ([]mailersend.SuppressionHardBouncesData, error) {
+			result, _, err := ms.Suppression.CreateHardBounce(ctx, &mailersend.CreateSuppressionOptions{
+				DomainID:   domainID,
+				Recipients: batch,
+			})
+			if err != nil {
+				return nil, sdkclient.WrapError(err)
+			}
+			return result.Data, nil
 		})
 		if err != nil {
-			return sdkclient.WrapError(err)
+			return err
 		}
 
 		if cmdutil.JSONFlag(c) {
-			return output.JSON(result)
+			return output.JSON(addedEntries[mailersend.SuppressionHardBouncesData]{created})
 		}
 
-		output.Success("Hard bounce entries added successfully.")
+		output.Success(fmt.Sprintf("Added %d hard bounce entries.", len(created)))
 		return nil
 	},
 }
@@ -336,8 +505,10 @@ func init() {
 
 	addListFlags(hardBouncesListCmd)
 
-	hardBouncesAddCmd.Flags().String("domain", "", "domain name or ID (required)")
+	hardBouncesAddCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	hardBouncesAddCmd.Flags().StringSlice("recipients", nil, "recipient emails")
+	addCommentFlag(hardBouncesAddCmd)
+	addFileFlag(hardBouncesAddCmd)
 
 	addDeleteFlags(hardBouncesDeleteCmd)
 }
@@ -359,7 +530,7 @@ var spamComplaintsListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		limit, _ := c.Flags().GetInt("limit")
+		limit, fetchLimit, search := searchFlags(c)
 
 		domainID, _ := c.Flags().GetString("domain")
 		if domainID != "" {
@@ -369,28 +540,11 @@ var spamComplaintsListCmd = &cobra.Command{
 			}
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]suppressionItem, bool, error) {
-			root, _, err := ms.Suppression.ListSpamComplaints(ctx, &mailersend.SuppressionOptions{
-				DomainID: domainID,
-				Page:     page,
-				Limit:    perPage,
-			})
-			if err != nil {
-				return nil, false, sdkclient.WrapError(err)
-			}
-			var out []suppressionItem
-			for _, d := range root.Data {
-				out = append(out, suppressionItem{
-					ID:           d.ID,
-					PatternEmail: d.Recipient.Email,
-					CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
-				})
-			}
-			return out, root.Next != "", nil
-		}, limit)
+		items, err := cmdutil.FetchList(ctx, c, suppressions.SpamComplaintsPages(ms, domainID), fetchLimit)
 		if err != nil {
 			return err
 		}
+		items = searchItems(items, search, limit)
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
@@ -399,10 +553,10 @@ var spamComplaintsListCmd = &cobra.Command{
 		headers := []string{"ID", "TYPE", "PATTERN/EMAIL", "CREATED AT"}
 		var rows [][]string
 		for _, i := range items {
-			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, i.CreatedAt})
+			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, output.FormatTimeString(i.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -418,7 +572,7 @@ var spamComplaintsAddCmd = &cobra.Command{
 
 		ctx := context.Background()
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -427,21 +581,32 @@ var spamComplaintsAddCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		recipients, _ := c.Flags().GetStringSlice("recipients")
+		recipients, err := addRecipients(c)
+		if err != nil {
+			return err
+		}
+
+		noteIgnoredComment(c, "spam complaints")
 
-		result, _, err := ms.Suppression.CreateSpamComplaint(ctx, &mailersend.CreateSuppressionOptions{
-			DomainID:   domainID,
-			Recipients: recipients,
+		created, err := suppressions.AddInBatches(recipients, func(batch []string) ([]mailersend.SuppressionSpamComplaintsData, error) {
+			result, _, err := ms.Suppression.CreateSpamComplaint(ctx, &mailersend.CreateSuppressionOptions{
+				DomainID:   domainID,
+				Recipients: batch,
+			})
+			if err != nil {
+				return nil, sdkclient.WrapError(err)
+			}
+			return result.Data, nil
 		})
 		if err != nil {
-			return sdkclient.WrapError(err)
+			return err
 		}
 
 		if cmdutil.JSONFlag(c) {
-			return output.JSON(result)
+			return output.JSON(addedEntries[mailersend.SuppressionSpamComplaintsData]{created})
 		}
 
-		output.Success("Spam complaint entries added successfully.")
+		output.Success(fmt.Sprintf("Added %d spam complaint entries.", len(created)))
 		return nil
 	},
 }
@@ -459,8 +624,10 @@ func init() {
 
 	addListFlags(spamComplaintsListCmd)
 
-	spamComplaintsAddCmd.Flags().String("domain", "", "domain name or ID (required)")
+	spamComplaintsAddCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	spamComplaintsAddCmd.Flags().StringSlice("recipients", nil, "recipient emails")
+	addCommentFlag(spamComplaintsAddCmd)
+	addFileFlag(spamComplaintsAddCmd)
 
 	addDeleteFlags(spamComplaintsDeleteCmd)
 }
@@ -482,7 +649,7 @@ var unsubscribesListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		limit, _ := c.Flags().GetInt("limit")
+		limit, fetchLimit, search := searchFlags(c)
 
 		domainID, _ := c.Flags().GetString("domain")
 		if domainID != "" {
@@ -492,28 +659,11 @@ var unsubscribesListCmd = &cobra.Command{
 			}
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]suppressionItem, bool, error) {
-			root, _, err := ms.Suppression.ListUnsubscribes(ctx, &mailersend.SuppressionOptions{
-				DomainID: domainID,
-				Page:     page,
-				Limit:    perPage,
-			})
-			if err != nil {
-				return nil, false, sdkclient.WrapError(err)
-			}
-			var out []suppressionItem
-			for _, d := range root.Data {
-				out = append(out, suppressionItem{
-					ID:           d.ID,
-					PatternEmail: d.Recipient.Email,
-					CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
-				})
-			}
-			return out, root.Next != "", nil
-		}, limit)
+		items, err := cmdutil.FetchList(ctx, c, suppressions.UnsubscribesPages(ms, domainID), fetchLimit)
 		if err != nil {
 			return err
 		}
+		items = searchItems(items, search, limit)
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
@@ -522,10 +672,10 @@ var unsubscribesListCmd = &cobra.Command{
 		headers := []string{"ID", "TYPE", "PATTERN/EMAIL", "CREATED AT"}
 		var rows [][]string
 		for _, i := range items {
-			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, i.CreatedAt})
+			rows = append(rows, []string{i.ID, i.Type, i.PatternEmail, output.FormatTimeString(i.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -541,7 +691,7 @@ var unsubscribesAddCmd = &cobra.Command{
 
 		ctx := context.Background()
 
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
@@ -550,21 +700,32 @@ var unsubscribesAddCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		recipients, _ := c.Flags().GetStringSlice("recipients")
+		recipients, err := addRecipients(c)
+		if err != nil {
+			return err
+		}
+
+		noteIgnoredComment(c, "unsubscribes")
 
-		result, _, err := ms.Suppression.CreateUnsubscribe(ctx, &mailersend.CreateSuppressionOptions{
-			DomainID:   domainID,
-			Recipients: recipients,
+		created, err := suppressions.AddInBatches(recipients, func(batch []string) ([]mailersend.SuppressionUnsubscribesData, error) {
+			result, _, err := ms.Suppression.CreateUnsubscribe(ctx, &mailersend.CreateSuppressionOptions{
+				DomainID:   domainID,
+				Recipients: batch,
+			})
+			if err != nil {
+				return nil, sdkclient.WrapError(err)
+			}
+			return result.Data, nil
 		})
 		if err != nil {
-			return sdkclient.WrapError(err)
+			return err
 		}
 
 		if cmdutil.JSONFlag(c) {
-			return output.JSON(result)
+			return output.JSON(addedEntries[mailersend.SuppressionUnsubscribesData]{created})
 		}
 
-		output.Success("Unsubscribe entries added successfully.")
+		output.Success(fmt.Sprintf("Added %d unsubscribe entries.", len(created)))
 		return nil
 	},
 }
@@ -582,8 +743,10 @@ func init() {
 
 	addListFlags(unsubscribesListCmd)
 
-	unsubscribesAddCmd.Flags().String("domain", "", "domain name or ID (required)")
+	unsubscribesAddCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	unsubscribesAddCmd.Flags().StringSlice("recipients", nil, "recipient emails")
+	addCommentFlag(unsubscribesAddCmd)
+	addFileFlag(unsubscribesAddCmd)
 
 	addDeleteFlags(unsubscribesDeleteCmd)
 }
@@ -591,7 +754,8 @@ func init() {
 // --- on-hold ---
 // The SDK does not have dedicated on-hold endpoints, so we use raw HTTP
 // requests via the SDK's HTTP client (which includes the CLI transport for
-// retries, verbose logging, and base URL rewrite).
+// retries, verbose logging, and base URL rewrite). Listing is shared with
+// verification via sdkclient.ListOnHold.
 
 var onHoldCmd = &cobra.Command{
 	Use:   "on-hold",
@@ -608,7 +772,7 @@ var onHoldListCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		limit, _ := c.Flags().GetInt("limit")
+		limit, fetchLimit, search := searchFlags(c)
 
 		domainID, _ := c.Flags().GetString("domain")
 		if domainID != "" {
@@ -618,57 +782,11 @@ var onHoldListCmd = &cobra.Command{
 			}
 		}
 
-		type rawItem struct {
-			ID        string `json:"id"`
-			Type      string `json:"type"`
-			Pattern   string `json:"pattern"`
-			Recipient struct {
-				Email string `json:"email"`
-			} `json:"recipient"`
-			CreatedAt string `json:"created_at"`
-		}
-
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]rawItem, bool, error) {
-			url := fmt.Sprintf("https://api.mailersend.com/v1/suppressions/on-hold-list?page=%d&limit=%d", page, perPage)
-			if domainID != "" {
-				url += "&domain_id=" + domainID
-			}
-			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-			if err != nil {
-				return nil, false, err
-			}
-			req.Header.Set("Authorization", "Bearer "+ms.APIKey())
-			req.Header.Set("Accept", "application/json")
-
-			resp, err := ms.Client().Do(req)
-			if err != nil {
-				return nil, false, err
-			}
-			defer resp.Body.Close() //nolint:errcheck
-
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, false, err
-			}
-
-			if resp.StatusCode >= 400 {
-				return nil, false, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
-			}
-
-			var parsed struct {
-				Data  []rawItem `json:"data"`
-				Links struct {
-					Next string `json:"next"`
-				} `json:"links"`
-			}
-			if err := json.Unmarshal(body, &parsed); err != nil {
-				return nil, false, fmt.Errorf("failed to parse response: %w", err)
-			}
-			return parsed.Data, parsed.Links.Next != "", nil
-		}, limit)
+		items, err := sdkclient.ListOnHold(ctx, ms, domainID, fetchLimit)
 		if err != nil {
 			return err
 		}
+		items = searchOnHold(items, search, limit)
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
@@ -681,10 +799,10 @@ var onHoldListCmd = &cobra.Command{
 			if value == "" {
 				value = i.Recipient.Email
 			}
-			rows = append(rows, []string{i.ID, i.Type, value, i.CreatedAt})
+			rows = append(rows, []string{i.ID, i.Type, value, output.FormatTimeString(i.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -702,16 +820,24 @@ var onHoldDeleteCmd = &cobra.Command{
 
 		payload := map[string]interface{}{}
 
-		if ids, _ := c.Flags().GetStringSlice("ids"); len(ids) > 0 {
+		ids, _ := c.Flags().GetStringSlice("ids")
+		if len(ids) > 0 {
 			payload["ids"] = ids
 		}
-		if all, _ := c.Flags().GetBool("all"); all {
+		all, _ := c.Flags().GetBool("all")
+		if all {
 			payload["all"] = true
 		}
 
 		if len(payload) == 0 {
 			return fmt.Errorf("provide --ids or --all")
 		}
+		if all {
+			yes, _ := c.Flags().GetBool("yes")
+			if err := prompt.ConfirmDestructive(yes, deleteAllLabel("on-hold", "")); err != nil {
+				return err
+			}
+		}
 
 		bodyBytes, err := json.Marshal(payload)
 		if err != nil {
@@ -734,7 +860,15 @@ var onHoldDeleteCmd = &cobra.Command{
 
 		if resp.StatusCode >= 400 {
 			body, _ := io.ReadAll(resp.Body)
-			return fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+			return sdkclient.ResponseError(resp.StatusCode, body)
+		}
+
+		if cmdutil.JSONFlag(c) {
+			id := strings.Join(ids, ",")
+			if all {
+				id = "all"
+			}
+			return output.Deleted("on_hold", id)
 		}
 
 		output.Success("On-hold entries deleted successfully.")
@@ -748,7 +882,9 @@ func init() {
 
 	onHoldListCmd.Flags().Int("limit", 0, "maximum number of items to return (0 = all)")
 	onHoldListCmd.Flags().String("domain", "", "filter by domain name or ID")
+	onHoldListCmd.Flags().String("search", "", "only show entries whose email or pattern contains this text")
 
 	onHoldDeleteCmd.Flags().StringSlice("ids", nil, "IDs to delete")
 	onHoldDeleteCmd.Flags().Bool("all", false, "delete all entries")
+	onHoldDeleteCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt for --all")
 }
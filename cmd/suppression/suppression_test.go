@@ -0,0 +1,307 @@
+package suppression
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func TestBlocklistAdd_SendsComment(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/suppressions/blocklist" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&got) //nolint:errcheck
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"bl-1","type":"email","pattern":"spam@example.com"}]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "blocklist", "add",
+		"--domain", "dom-1",
+		"--recipients", "spam@example.com",
+		"--comment", "reported by support"})
+	defer blocklistAddCmd.Flags().Set("comment", "") //nolint:errcheck
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if got["comment"] != "reported by support" {
+		t.Errorf("comment = %v, want %q", got["comment"], "reported by support")
+	}
+	if got["domain_id"] != "dom-1" {
+		t.Errorf("domain_id = %v, want dom-1", got["domain_id"])
+	}
+	if r, _ := got["recipients"].([]interface{}); len(r) != 1 || r[0] != "spam@example.com" {
+		t.Errorf("recipients = %v, want [spam@example.com]", got["recipients"])
+	}
+}
+
+func TestBlocklistDeleteAll_RequiresYesWhenNotInteractive(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	// A pipe on stdin makes the command non-interactive.
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stdinW.Close() //nolint:errcheck
+	origStdin := os.Stdin
+	os.Stdin = stdinR
+	defer func() { os.Stdin = origStdin }()
+	defer blocklistDeleteCmd.Flags().Set("all", "false") //nolint:errcheck
+	defer blocklistDeleteCmd.Flags().Set("yes", "false") //nolint:errcheck
+
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "blocklist", "delete", "--all"})
+	err = root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Fatalf("expected an error asking for --yes, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("made %d requests without confirmation", requests)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"suppression", "blocklist", "delete", "--all", "--yes"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests with --yes, want 1", requests)
+	}
+}
+
+func TestUnsubscribesAdd_FileIsBatched(t *testing.T) {
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/suppressions/unsubscribes" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Recipients []string `json:"recipients"`
+		}
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+		batches = append(batches, len(body.Recipients))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	var lines []string
+	lines = append(lines, "# exported from the old provider", "")
+	for i := 0; i < suppressions.MaxAddBatch; i++ {
+		lines = append(lines, fmt.Sprintf("user%d@example.com", i))
+	}
+	path := filepath.Join(t.TempDir(), "unsubscribes.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "unsubscribes", "add", "--domain", "dom-1", "--recipients", "extra@example.com", "--file", path})
+	defer unsubscribesAddCmd.Flags().Set("file", "")                                            //nolint:errcheck
+	defer unsubscribesAddCmd.Flags().Lookup("recipients").Value.(pflag.SliceValue).Replace(nil) //nolint:errcheck
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if len(batches) != 2 || batches[0] != suppressions.MaxAddBatch || batches[1] != 1 {
+		t.Errorf("batch sizes = %v, want [%d 1]", batches, suppressions.MaxAddBatch)
+	}
+}
+
+func TestUnsubscribesAdd_BatchFailureReportsProgress(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 2 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message":"The recipients field is invalid."}`)) //nolint:errcheck
+			return
+		}
+		// The API reports fewer created entries than were sent, e.g. when
+		// some were already on the list.
+		w.Write([]byte(`{"data":[{"id":"u1"},{"id":"u2"},{"id":"u3"}]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	var lines []string
+	for i := 0; i <= suppressions.MaxAddBatch; i++ {
+		lines = append(lines, fmt.Sprintf("user%d@example.com", i))
+	}
+	path := filepath.Join(t.TempDir(), "unsubscribes.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "unsubscribes", "add", "--domain", "dom-1", "--file", path})
+	defer unsubscribesAddCmd.Flags().Set("file", "") //nolint:errcheck
+
+	err := root.Execute()
+	want := fmt.Sprintf("added 3 of %d before failure: API error 422: The recipients field is invalid.", suppressions.MaxAddBatch+1)
+	if err == nil || err.Error() != want {
+		t.Fatalf("error = %v, want %q", err, want)
+	}
+}
+
+func TestUnsubscribesAdd_ReportsCreatedCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"u1"}]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "unsubscribes", "add", "--domain", "dom-1", "--recipients", "a@example.com,b@example.com"})
+	defer unsubscribesAddCmd.Flags().Lookup("recipients").Value.(pflag.SliceValue).Replace(nil) //nolint:errcheck
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Added 1 unsubscribe entries.") {
+		t.Errorf("expected the API's created count, got:\n%s", out)
+	}
+}
+
+func TestBlocklistAdd_FileSplitsPatterns(t *testing.T) {
+	var got struct {
+		Recipients []string `json:"recipients"`
+		Patterns   []string `json:"patterns"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got) //nolint:errcheck
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	if err := os.WriteFile(path, []byte("spam@example.com\n# patterns\n*@spam.example\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Flags keep their values across tests.
+	if err := blocklistAddCmd.Flags().Lookup("recipients").Value.(pflag.SliceValue).Replace(nil); err != nil {
+		t.Fatal(err)
+	}
+	root := newRootCmd()
+	root.SetArgs([]string{"suppression", "blocklist", "add", "--domain", "dom-1", "--file", path})
+	defer blocklistAddCmd.Flags().Set("file", "") //nolint:errcheck
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if strings.Join(got.Recipients, ",") != "spam@example.com" || strings.Join(got.Patterns, ",") != "*@spam.example" {
+		t.Errorf("recipients = %v, patterns = %v", got.Recipients, got.Patterns)
+	}
+}
+
+func TestHardBouncesList_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": []map[string]interface{}{
+				{"id": "hb-1", "recipient": map[string]string{"email": "foo@bar.com"}},
+				{"id": "hb-2", "recipient": map[string]string{"email": "other@example.com"}},
+				{"id": "hb-3", "recipient": map[string]string{"email": "Foo@Bar.com.au"}},
+			},
+			"links": map[string]string{"next": ""},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"suppression", "hard-bounces", "list", "--search", "foo@bar.com", "--limit", "1"})
+	defer hardBouncesListCmd.Flags().Set("search", "") //nolint:errcheck
+	defer hardBouncesListCmd.Flags().Set("limit", "0") //nolint:errcheck
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var items []suppressions.Item
+	if err := json.Unmarshal([]byte(out), &items); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(items) != 1 || items[0].ID != "hb-1" {
+		t.Errorf("items = %+v, want only hb-1 (search, then limit)", items)
+	}
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
@@ -23,6 +23,7 @@ func init() {
 	Cmd.AddCommand(deleteCmd)
 
 	listCmd.Flags().Int("limit", 0, "maximum number of templates to return")
+	cmdutil.AddPageFlags(listCmd)
 	listCmd.Flags().String("domain", "", "filter by domain name or ID")
 }
 
@@ -51,7 +52,7 @@ func runList(c *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
-	items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Template, bool, error) {
+	items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.Template, bool, error) {
 		root, _, err := ms.Template.List(ctx, &mailersend.ListTemplateOptions{
 			DomainID: domainID,
 			Page:     page,
@@ -78,11 +79,11 @@ func runList(c *cobra.Command, args []string) error {
 			t.ID,
 			output.Truncate(t.Name, 40),
 			t.Type,
-			t.CreatedAt,
+			output.FormatTimeString(t.CreatedAt),
 		})
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
@@ -117,7 +118,7 @@ func runGet(c *cobra.Command, args []string) error {
 	fmt.Printf("Name:         %s\n", d.Name)
 	fmt.Printf("Type:         %s\n", d.Type)
 	fmt.Printf("Image Path:   %s\n", d.ImagePath)
-	fmt.Printf("Created At:   %s\n", d.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Created At:   %s\n", output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05"))
 
 	if d.Category != nil {
 		if cat, ok := d.Category.(map[string]interface{}); ok {
@@ -142,7 +143,7 @@ func runGet(c *cobra.Command, args []string) error {
 	fmt.Printf("  Sent:           %d\n", d.TemplateStats.Sent)
 	fmt.Printf("  Rejected:       %d\n", d.TemplateStats.Rejected)
 	fmt.Printf("  Delivered:      %d\n", d.TemplateStats.Delivered)
-	fmt.Printf("  Last Sent At:   %s\n", d.TemplateStats.LastEmailSentAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("  Last Sent At:   %s\n", output.FormatTime(d.TemplateStats.LastEmailSentAt, "2006-01-02 15:04:05"))
 
 	return nil
 }
@@ -168,6 +169,10 @@ func runDelete(c *cobra.Command, args []string) error {
 		return sdkclient.WrapError(err)
 	}
 
+	if cmdutil.JSONFlag(c) {
+		return output.Deleted("template", args[0])
+	}
+
 	output.Success("Template " + args[0] + " deleted successfully.")
 	return nil
 }
@@ -0,0 +1,29 @@
+package token
+
+import (
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/scopes"
+	"github.com/spf13/cobra"
+)
+
+var scopesCmd = &cobra.Command{
+	Use:   "scopes",
+	Short: "List the scopes a token can have",
+	Long: "List the scopes accepted by `token create --scopes`, grouped by resource.\n" +
+		"A resource's _full scope includes its _read scope.",
+	Args: cobra.NoArgs,
+	RunE: func(c *cobra.Command, args []string) error {
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(scopes.All)
+		}
+
+		headers := []string{"RESOURCE", "SCOPE", "DESCRIPTION"}
+		var rows [][]string
+		for _, s := range scopes.All {
+			rows = append(rows, []string{s.Resource, s.Name, s.Description})
+		}
+		output.Render(headers, rows)
+		return nil
+	},
+}
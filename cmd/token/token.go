@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/mailersend/mailersend-cli/internal/scopes"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
 	"github.com/mailersend/mailersend-go"
 	"github.com/spf13/cobra"
@@ -29,16 +31,23 @@ func init() {
 	Cmd.AddCommand(updateCmd)
 	Cmd.AddCommand(updateStatusCmd)
 	Cmd.AddCommand(deleteCmd)
+	Cmd.AddCommand(scopesCmd)
 
 	listCmd.Flags().Int("limit", 0, "maximum number of tokens to return (0 = all)")
+	cmdutil.AddPageFlags(listCmd)
 
 	createCmd.Flags().String("name", "", "token name (required)")
-	createCmd.Flags().String("domain", "", "domain name or ID (required)")
-	createCmd.Flags().StringSlice("scopes", nil, "token scopes (required)")
+	createCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
+	createCmd.Flags().StringSlice("scopes", nil, "token scopes (required; see `token scopes`)")
+	createCmd.Flags().Bool("allow-unknown-scopes", false, "send scopes this CLI does not know about instead of rejecting them")
+	createCmd.Flags().String("save-token-to", "", "write the access token to this file (mode 0600) instead of printing it")
 
 	updateCmd.Flags().String("name", "", "token name")
 
 	updateStatusCmd.Flags().String("status", "", "token status: pause or unpause (required)")
+	_ = updateStatusCmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions([]string{"pause", "unpause"}, cobra.ShellCompDirectiveNoFileComp))
+
+	deleteCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt")
 }
 
 // --- list ---
@@ -63,7 +72,7 @@ var listCmd = &cobra.Command{
 			CreatedAt string `json:"created_at"`
 		}
 
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]tokenItem, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]tokenItem, bool, error) {
 			url := fmt.Sprintf("https://api.mailersend.com/v1/token?page=%d&limit=%d", page, perPage)
 			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 			if err != nil {
@@ -84,7 +93,7 @@ var listCmd = &cobra.Command{
 			}
 
 			if resp.StatusCode >= 400 {
-				return nil, false, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+				return nil, false, sdkclient.ResponseError(resp.StatusCode, body)
 			}
 
 			var parsed struct {
@@ -109,10 +118,10 @@ var listCmd = &cobra.Command{
 		headers := []string{"ID", "NAME", "STATUS", "CREATED AT"}
 		var rows [][]string
 		for _, t := range items {
-			rows = append(rows, []string{t.ID, t.Name, t.Status, t.CreatedAt})
+			rows = append(rows, []string{t.ID, t.Name, t.Status, output.FormatTimeString(t.CreatedAt)})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -151,15 +160,11 @@ var getCmd = &cobra.Command{
 		}
 
 		if resp.StatusCode >= 400 {
-			return fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+			return sdkclient.ResponseError(resp.StatusCode, body)
 		}
 
 		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
+			return output.RawJSON(body)
 		}
 
 		var parsed struct {
@@ -180,9 +185,9 @@ var getCmd = &cobra.Command{
 			{"ID", d.ID},
 			{"Name", d.Name},
 			{"Status", d.Status},
-			{"Created At", d.CreatedAt},
+			{"Created At", output.FormatTimeString(d.CreatedAt)},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -205,42 +210,128 @@ var createCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		domainID, _ := c.Flags().GetString("domain")
+		domainID := cmdutil.DomainFlag(c)
 		domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 		if err != nil {
 			return err
 		}
-		domainID, err = cmdutil.ResolveDomainSDK(ms, domainID)
+		scopeNames, _ := c.Flags().GetStringSlice("scopes")
+		scopeNames, err = prompt.RequireSliceArg(scopeNames, "scopes", "Token scopes")
 		if err != nil {
 			return err
 		}
-		scopes, _ := c.Flags().GetStringSlice("scopes")
-		scopes, err = prompt.RequireSliceArg(scopes, "scopes", "Token scopes")
+		// Checked before resolving the domain so that a typo costs no API
+		// requests.
+		if allow, _ := c.Flags().GetBool("allow-unknown-scopes"); !allow {
+			if err := scopes.Validate(scopeNames); err != nil {
+				return err
+			}
+		}
+		domainID, err = cmdutil.ResolveDomainSDK(ms, domainID)
 		if err != nil {
 			return err
 		}
 
+		// Open the token file before creating the token: the token is only
+		// ever shown once, so a path that cannot be written must fail first.
+		tokenFile, _ := c.Flags().GetString("save-token-to")
+		var tf *tokenFileWriter
+		if tokenFile != "" {
+			tf, err = openTokenFile(tokenFile)
+			if err != nil {
+				return err
+			}
+		}
+
 		result, _, err := ms.Token.Create(ctx, &mailersend.CreateTokenOptions{
 			Name:     name,
 			DomainID: domainID,
-			Scopes:   scopes,
+			Scopes:   scopeNames,
 		})
 		if err != nil {
+			tf.discard()
 			return sdkclient.WrapError(err)
 		}
 
+		if tf != nil {
+			if err := tf.write(result.Data.AccessToken); err != nil {
+				if result.Data.AccessToken != "" {
+					fmt.Fprintf(os.Stderr, "Access Token: %s\n", result.Data.AccessToken)
+				}
+				return err
+			}
+			// The token is only ever shown once; keep it out of stdout.
+			result.Data.AccessToken = ""
+		}
+
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(result)
 		}
 
 		output.Success("Token created successfully. ID: " + result.Data.ID)
-		if result.Data.AccessToken != "" {
+		if tokenFile != "" {
+			output.Success("Access token written to " + tokenFile)
+		} else if result.Data.AccessToken != "" {
 			fmt.Printf("Access Token: %s\n", result.Data.AccessToken)
 		}
 		return nil
 	},
 }
 
+// tokenFileWriter is a --save-token-to file opened ahead of the API call.
+type tokenFileWriter struct {
+	f       *os.File
+	created bool
+}
+
+// openTokenFile opens path for the access token with owner-only permissions,
+// leaving any existing contents in place until the token is written.
+func openTokenFile(path string) (*tokenFileWriter, error) {
+	_, statErr := os.Lstat(path)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open token file: %w", err)
+	}
+	tf := &tokenFileWriter{f: f, created: os.IsNotExist(statErr)}
+	if err := f.Chmod(0600); err != nil {
+		tf.discard()
+		return nil, fmt.Errorf("failed to set token file permissions: %w", err)
+	}
+	return tf, nil
+}
+
+// write replaces the file's contents with token, and nothing else.
+func (tf *tokenFileWriter) write(token string) error {
+	if token == "" {
+		tf.discard()
+		return fmt.Errorf("the API response did not include an access token; nothing written to %s", tf.f.Name())
+	}
+	if err := tf.f.Truncate(0); err != nil {
+		tf.f.Close() //nolint:errcheck
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if _, err := tf.f.WriteString(token); err != nil {
+		tf.f.Close() //nolint:errcheck
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := tf.f.Close(); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	return nil
+}
+
+// discard closes the file, removing it if openTokenFile created it. It is a
+// no-op on a nil writer.
+func (tf *tokenFileWriter) discard() {
+	if tf == nil {
+		return
+	}
+	tf.f.Close() //nolint:errcheck
+	if tf.created {
+		os.Remove(tf.f.Name()) //nolint:errcheck
+	}
+}
+
 // --- update ---
 // The SDK's Update only supports status changes (PUT /token/{id}/settings).
 // For name updates via PUT /v1/token/{id}, we use raw HTTP.
@@ -290,15 +381,11 @@ var updateCmd = &cobra.Command{
 		}
 
 		if resp.StatusCode >= 400 {
-			return fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+			return sdkclient.ResponseError(resp.StatusCode, respBody)
 		}
 
 		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(respBody, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
+			return output.RawJSON(respBody)
 		}
 
 		output.Success("Token " + args[0] + " updated successfully.")
@@ -355,12 +442,21 @@ var deleteCmd = &cobra.Command{
 			return err
 		}
 
+		yes, _ := c.Flags().GetBool("yes")
+		if err := prompt.ConfirmDestructive(yes, fmt.Sprintf("Delete API token %s? Anything using it will stop working.", args[0])); err != nil {
+			return err
+		}
+
 		ctx := context.Background()
 		_, err = ms.Token.Delete(ctx, args[0])
 		if err != nil {
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("token", args[0])
+		}
+
 		output.Success("Token " + args[0] + " deleted successfully.")
 		return nil
 	},
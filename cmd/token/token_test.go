@@ -0,0 +1,220 @@
+package token
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/scopes"
+	"github.com/spf13/cobra"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestTokenCreateCmd_SaveTokenTo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/token" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"tok-1","accessToken":"mlsn.secret-value","name":"ci","status":"unpause"}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	path := filepath.Join(t.TempDir(), "token")
+	root := newRootCmd()
+	root.SetArgs([]string{"token", "create", "--name", "ci", "--domain", "dom-1", "--scopes", "email_full", "--save-token-to", path})
+	defer createCmd.Flags().Set("save-token-to", "") //nolint:errcheck
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("token file not written: %v", err)
+	}
+	if string(data) != "mlsn.secret-value" {
+		t.Errorf("token file = %q, want only the access token", data)
+	}
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("token file mode = %o, want 600", perm)
+		}
+	}
+	if strings.Contains(out, "mlsn.secret-value") {
+		t.Errorf("access token was printed to stdout:\n%s", out)
+	}
+}
+
+func TestTokenCreateCmd_SaveTokenToUnwritableCreatesNothing(t *testing.T) {
+	var created bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		created = true
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"tok-1","accessToken":"mlsn.secret-value"}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	path := filepath.Join(t.TempDir(), "missing-dir", "token")
+	root := newRootCmd()
+	root.SetArgs([]string{"token", "create", "--name", "ci", "--domain", "dom-1", "--scopes", "email_full", "--save-token-to", path})
+	defer createCmd.Flags().Set("save-token-to", "") //nolint:errcheck
+
+	if err := root.Execute(); err == nil {
+		t.Fatal("expected an error for an unwritable token file")
+	}
+	if created {
+		t.Error("token was created even though the token file could not be opened")
+	}
+}
+
+func TestTokenUpdateCmd_NoContentJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/token/tok-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"token", "update", "tok-1", "--name", "renamed"})
+	defer updateCmd.Flags().Set("name", "") //nolint:errcheck
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if strings.TrimSpace(out) != `{
+  "status": "ok"
+}` {
+		t.Errorf("output = %q, want the synthesized ok status", out)
+	}
+}
+
+func TestTokenScopesCmd_JSON(t *testing.T) {
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"token", "scopes"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	var got []scopes.Scope
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	names := map[string]bool{}
+	for _, s := range got {
+		if s.Resource == "" || s.Description == "" {
+			t.Errorf("scope %q is missing its resource or description", s.Name)
+		}
+		names[s.Name] = true
+	}
+	// The full scopes `auth login` has always requested.
+	for _, want := range strings.Fields("email_full tokens_full webhooks_full templates_full inbounds_full " +
+		"domains_full activity_full analytics_full suppressions_full sms_full " +
+		"email_verification_full recipients_full sender_identity_full " +
+		"smtp_users_full users_full dmarc_monitoring_full") {
+		if !names[want] {
+			t.Errorf("scope %q missing", want)
+		}
+	}
+}
+
+func TestTokenCreateCmd_RejectsUnknownScopes(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"tok-1","accessToken":"mlsn.x","name":"ci"}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer createCmd.Flags().Set("allow-unknown-scopes", "false") //nolint:errcheck
+
+	root := newRootCmd()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	// A domain name would be resolved through the API; the scopes are
+	// checked first.
+	root.SetArgs([]string{"token", "create", "--name", "ci", "--domain", "example.com", "--scopes", "email_full,domain_read"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `"domain_read" (did you mean "domains_read"?)`) {
+		t.Fatalf("expected an unknown scope error with a suggestion, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("made %d API requests for invalid scopes", requests)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"token", "create", "--name", "ci", "--domain", "dom-1", "--scopes", "brand_new_full", "--allow-unknown-scopes"})
+	captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("--allow-unknown-scopes returned error: %v", err)
+		}
+	})
+	if requests != 1 {
+		t.Errorf("made %d API requests with --allow-unknown-scopes, want 1", requests)
+	}
+}
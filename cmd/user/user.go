@@ -30,6 +30,7 @@ func init() {
 	Cmd.AddCommand(deleteCmd)
 
 	listCmd.Flags().Int("limit", 0, "maximum number of users to return (0 = all)")
+	cmdutil.AddPageFlags(listCmd)
 
 	inviteCmd.AddCommand(inviteCreateCmd)
 	inviteCmd.AddCommand(inviteListCmd)
@@ -44,6 +45,9 @@ func init() {
 	inviteCreateCmd.Flags().StringSlice("domains", nil, "domain IDs")
 
 	inviteListCmd.Flags().Int("limit", 0, "maximum number of invites to return (0 = all)")
+	cmdutil.AddPageFlags(inviteListCmd)
+
+	deleteCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt")
 
 	updateCmd.Flags().String("role", "", "user role")
 	updateCmd.Flags().StringSlice("permissions", nil, "permissions")
@@ -63,7 +67,7 @@ var listCmd = &cobra.Command{
 		limit, _ := c.Flags().GetInt("limit")
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.User, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.User, bool, error) {
 			root, _, err := ms.User.List(ctx, &mailersend.ListUserOptions{
 				Page:  page,
 				Limit: perPage,
@@ -87,7 +91,7 @@ var listCmd = &cobra.Command{
 			rows = append(rows, []string{u.ID, u.Email, u.Role})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -119,7 +123,7 @@ var getCmd = &cobra.Command{
 			{"Email", d.Email},
 			{"Role", d.Role},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -173,11 +177,7 @@ var inviteCreateCmd = &cobra.Command{
 		}
 
 		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
+			return output.RawJSON(body)
 		}
 
 		output.Success("User invitation sent to " + email + ".")
@@ -198,7 +198,7 @@ var inviteListCmd = &cobra.Command{
 		limit, _ := c.Flags().GetInt("limit")
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]inviteItem, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]inviteItem, bool, error) {
 			url := fmt.Sprintf("https://api.mailersend.com/v1/invites?page=%d&limit=%d", page, perPage)
 			body, err := doRawRequest(ms, ctx, http.MethodGet, url, nil)
 			if err != nil {
@@ -230,7 +230,7 @@ var inviteListCmd = &cobra.Command{
 			rows = append(rows, []string{i.ID, i.Email, i.Role})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -252,11 +252,7 @@ var inviteGetCmd = &cobra.Command{
 		}
 
 		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
+			return output.RawJSON(body)
 		}
 
 		var resp struct {
@@ -277,7 +273,7 @@ var inviteGetCmd = &cobra.Command{
 			{"Email", d.Email},
 			{"Role", d.Role},
 		}
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -362,11 +358,7 @@ var updateCmd = &cobra.Command{
 		}
 
 		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
+			return output.RawJSON(body)
 		}
 
 		output.Success("User " + args[0] + " updated successfully.")
@@ -384,12 +376,21 @@ var deleteCmd = &cobra.Command{
 			return err
 		}
 
+		yes, _ := c.Flags().GetBool("yes")
+		if err := prompt.ConfirmDestructive(yes, fmt.Sprintf("Delete user %s from the account?", args[0])); err != nil {
+			return err
+		}
+
 		ctx := context.Background()
 		_, err = ms.User.Delete(ctx, args[0])
 		if err != nil {
 			return sdkclient.WrapError(err)
 		}
 
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("user", args[0])
+		}
+
 		output.Success("User " + args[0] + " deleted successfully.")
 		return nil
 	},
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"os"
+	"os/signal"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +20,7 @@ import (
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/prompt"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
 	"github.com/mailersend/mailersend-go"
 	"github.com/spf13/cobra"
 )
@@ -49,21 +54,41 @@ func init() {
 	listCmd.AddCommand(listCreateCmd)
 	listCmd.AddCommand(listVerifyCmd)
 	listCmd.AddCommand(listResultsCmd)
+	listCmd.AddCommand(listDeleteCmd)
 
 	// list list flags
 	listListCmd.Flags().Int("limit", 0, "maximum number of lists to return (0 = all)")
+	cmdutil.AddPageFlags(listListCmd)
 
 	// list create flags
 	listCreateCmd.Flags().String("name", "", "name for the verification list (required)")
 	listCreateCmd.Flags().StringSlice("emails", nil, "comma-separated list of email addresses")
 	listCreateCmd.Flags().String("emails-file", "", "path to file with one email per line")
+	listCreateCmd.Flags().Bool("from-on-hold", false, "add the emails on the domain's on-hold list")
+	listCreateCmd.Flags().String("domain", "", "domain name or ID whose on-hold list to use (with --from-on-hold)")
+	listCreateCmd.Flags().Int("limit", 0, "maximum number of on-hold entries to use (0 = all)")
+	listCreateCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
+
+	// list delete flags
+	listDeleteCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt")
+
+	// async status flags
+	verifyAsyncCmd.Flags().Bool("wait", false, "poll the verification status until it is final and print it")
+	cmdutil.AddWaitFlags(verifyAsyncCmd, 5*time.Second, 10*time.Minute)
+	statusCmd.Flags().Bool("wait", false, "poll until the status is final")
+	cmdutil.AddWaitFlags(statusCmd, 5*time.Second, 10*time.Minute)
 
 	// list verify flags
 	listVerifyCmd.Flags().Bool("wait", false, "poll until verification completes")
+	cmdutil.AddWaitFlags(listVerifyCmd, 5*time.Second, 30*time.Minute)
 
 	// list results flags
 	listResultsCmd.Flags().Int("limit", 0, "maximum number of results to return (0 = all)")
-	listResultsCmd.Flags().String("status", "", "filter by status (valid, invalid, catch_all, mailbox_full, role, unknown)")
+	cmdutil.AddPageFlags(listResultsCmd)
+	listResultsCmd.Flags().StringSlice("status", nil, "only include these results, comma-separated (e.g. valid, catch_all, role_based, disposable, or invalid for all undeliverable results)")
+	_ = listResultsCmd.RegisterFlagCompletionFunc("status", cmdutil.CompleteSlice(append(slices.Sorted(maps.Keys(resultReasons)), "invalid")))
+	listResultsCmd.Flags().String("suppress", "", "add the matching addresses to a suppression list (blocklist, hard-bounces, spam-complaints, unsubscribes)")
+	listResultsCmd.Flags().String("domain", "", "domain name or ID whose suppression list to add to (with --suppress)")
 }
 
 // --- Single-email commands ---
@@ -106,11 +131,7 @@ var verifyCmd = &cobra.Command{
 		}
 
 		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
+			return output.RawJSON(body)
 		}
 
 		var respData struct {
@@ -141,7 +162,7 @@ var verifyCmd = &cobra.Command{
 			}
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -182,14 +203,6 @@ var verifyAsyncCmd = &cobra.Command{
 			return parseHTTPError(resp.StatusCode, body)
 		}
 
-		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
-		}
-
 		var respData struct {
 			Data struct {
 				ID      string `json:"id"`
@@ -201,6 +214,18 @@ var verifyAsyncCmd = &cobra.Command{
 			return fmt.Errorf("failed to parse response: %w", err)
 		}
 
+		if wait, _ := c.Flags().GetBool("wait"); wait {
+			raw, status, err := waitForAsyncStatus(ctx, c, ms, respData.Data.ID)
+			if err != nil {
+				return err
+			}
+			return renderAsyncStatus(c, raw, status)
+		}
+
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(json.RawMessage(body))
+		}
+
 		headers := []string{"FIELD", "VALUE"}
 		rows := [][]string{
 			{"ID", respData.Data.ID},
@@ -208,7 +233,7 @@ var verifyAsyncCmd = &cobra.Command{
 			{"Status", respData.Data.Status},
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -225,66 +250,110 @@ var statusCmd = &cobra.Command{
 		}
 
 		ctx := context.Background()
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.mailersend.com/v1/email-verification/verify-async/"+args[0], nil)
+		var raw json.RawMessage
+		var status asyncStatus
+		if wait, _ := c.Flags().GetBool("wait"); wait {
+			raw, status, err = waitForAsyncStatus(ctx, c, ms, args[0])
+		} else {
+			raw, status, err = fetchAsyncStatus(ctx, ms, args[0])
+		}
 		if err != nil {
 			return err
 		}
-		req.Header.Set("Authorization", "Bearer "+ms.APIKey())
-		req.Header.Set("Accept", "application/json")
+		return renderAsyncStatus(c, raw, status)
+	},
+}
 
-		resp, err := ms.Client().Do(req)
-		if err != nil {
-			return sdkclient.WrapError(err)
-		}
-		defer resp.Body.Close() //nolint:errcheck
+// asyncStatus is the response of the async verification status endpoint.
+type asyncStatus struct {
+	Data struct {
+		ID      string          `json:"id"`
+		Address string          `json:"address"`
+		Status  string          `json:"status"`
+		Result  json.RawMessage `json:"result"`
+		Error   json.RawMessage `json:"error"`
+	} `json:"data"`
+}
 
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("failed to read response: %w", err)
-		}
+// asyncPendingStatuses are the async verification statuses that are not
+// final; --wait keeps polling while the status is one of them.
+var asyncPendingStatuses = map[string]bool{"queued": true, "processing": true, "pending": true}
+
+// fetchAsyncStatus gets an async verification's status, returning the raw
+// body for --json alongside the parsed response.
+func fetchAsyncStatus(ctx context.Context, ms *mailersend.Mailersend, id string) (json.RawMessage, asyncStatus, error) {
+	var status asyncStatus
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.mailersend.com/v1/email-verification/verify-async/"+id, nil)
+	if err != nil {
+		return nil, status, err
+	}
+	req.Header.Set("Authorization", "Bearer "+ms.APIKey())
+	req.Header.Set("Accept", "application/json")
 
-		if resp.StatusCode >= 400 {
-			return parseHTTPError(resp.StatusCode, body)
-		}
+	resp, err := ms.Client().Do(req)
+	if err != nil {
+		return nil, status, sdkclient.WrapError(err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
 
-		if cmdutil.JSONFlag(c) {
-			var raw json.RawMessage
-			if err := json.Unmarshal(body, &raw); err != nil {
-				return err
-			}
-			return output.JSON(raw)
-		}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("failed to read response: %w", err)
+	}
 
-		var respData struct {
-			Data struct {
-				ID      string          `json:"id"`
-				Address string          `json:"address"`
-				Status  string          `json:"status"`
-				Result  json.RawMessage `json:"result"`
-				Error   json.RawMessage `json:"error"`
-			} `json:"data"`
-		}
-		if err := json.Unmarshal(body, &respData); err != nil {
-			return fmt.Errorf("failed to parse response: %w", err)
-		}
+	if resp.StatusCode >= 400 {
+		return nil, status, parseHTTPError(resp.StatusCode, body)
+	}
 
-		headers := []string{"FIELD", "VALUE"}
-		rows := [][]string{
-			{"ID", respData.Data.ID},
-			{"Address", respData.Data.Address},
-			{"Status", respData.Data.Status},
-		}
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, status, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return body, status, nil
+}
 
-		if respData.Data.Result != nil && string(respData.Data.Result) != "null" {
-			rows = append(rows, []string{"Result", string(respData.Data.Result)})
+// waitForAsyncStatus polls an async verification until its status is final,
+// honoring --interval and --wait-timeout.
+func waitForAsyncStatus(ctx context.Context, c *cobra.Command, ms *mailersend.Mailersend, id string) (json.RawMessage, asyncStatus, error) {
+	var raw json.RawMessage
+	var status asyncStatus
+	interval, timeout := cmdutil.WaitFlags(c)
+	err := cmdutil.Poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		raw, status, err = fetchAsyncStatus(ctx, ms, id)
+		if err != nil {
+			return false, err
 		}
-		if respData.Data.Error != nil && string(respData.Data.Error) != "null" {
-			rows = append(rows, []string{"Error", string(respData.Data.Error)})
+		if asyncPendingStatuses[status.Data.Status] {
+			output.Note(fmt.Sprintf("Waiting... (status: %s)", status.Data.Status))
+			return false, nil
 		}
+		return true, nil
+	})
+	return raw, status, err
+}
 
-		output.Table(headers, rows)
-		return nil
-	},
+func renderAsyncStatus(c *cobra.Command, raw json.RawMessage, status asyncStatus) error {
+	if cmdutil.JSONFlag(c) {
+		return output.JSON(raw)
+	}
+
+	d := status.Data
+	headers := []string{"FIELD", "VALUE"}
+	rows := [][]string{
+		{"ID", d.ID},
+		{"Address", d.Address},
+		{"Status", d.Status},
+	}
+
+	if d.Result != nil && string(d.Result) != "null" {
+		rows = append(rows, []string{"Result", string(d.Result)})
+	}
+	if d.Error != nil && string(d.Error) != "null" {
+		rows = append(rows, []string{"Error", string(d.Error)})
+	}
+
+	output.Render(headers, rows)
+	return nil
 }
 
 // --- List subcommands ---
@@ -302,7 +371,7 @@ var listListCmd = &cobra.Command{
 		limit, _ := c.Flags().GetInt("limit")
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.EmailVerification, bool, error) {
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.EmailVerification, bool, error) {
 			root, _, err := ms.EmailVerification.List(ctx, &mailersend.ListEmailVerificationOptions{
 				Page:  page,
 				Limit: perPage,
@@ -327,10 +396,7 @@ var listListCmd = &cobra.Command{
 			if item.Status.Name != "" {
 				statusName = item.Status.Name
 			}
-			createdAt := ""
-			if !item.CreatedAt.IsZero() {
-				createdAt = item.CreatedAt.Format("2006-01-02 15:04:05")
-			}
+			createdAt := output.FormatTime(item.CreatedAt, "2006-01-02 15:04:05")
 
 			rows = append(rows, []string{
 				item.Id,
@@ -341,7 +407,7 @@ var listListCmd = &cobra.Command{
 			})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -375,11 +441,11 @@ var listGetCmd = &cobra.Command{
 
 		createdAt := ""
 		if !d.CreatedAt.IsZero() {
-			createdAt = d.CreatedAt.Format("2006-01-02 15:04:05")
+			createdAt = output.FormatTime(d.CreatedAt, "2006-01-02 15:04:05")
 		}
 		updatedAt := ""
 		if !d.UpdatedAt.IsZero() {
-			updatedAt = d.UpdatedAt.Format("2006-01-02 15:04:05")
+			updatedAt = output.FormatTime(d.UpdatedAt, "2006-01-02 15:04:05")
 		}
 
 		verificationStarted := fmt.Sprintf("%v", d.VerificationStarted)
@@ -419,7 +485,7 @@ var listGetCmd = &cobra.Command{
 			{"Failed", strconv.Itoa(stats.Failed)},
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
@@ -456,11 +522,33 @@ var listCreateCmd = &cobra.Command{
 			}
 		}
 
+		ctx := context.Background()
+
+		if fromOnHold, _ := c.Flags().GetBool("from-on-hold"); fromOnHold {
+			domain := cmdutil.DomainFlag(c)
+			domain, err = prompt.RequireArg(domain, "domain", "Domain name or ID")
+			if err != nil {
+				return err
+			}
+			domainID, err := cmdutil.ResolveDomainSDK(ms, domain)
+			if err != nil {
+				return err
+			}
+			limit, _ := c.Flags().GetInt("limit")
+			entries, err := sdkclient.ListOnHold(ctx, ms, domainID, limit)
+			if err != nil {
+				return err
+			}
+			if len(entries) == 0 {
+				return fmt.Errorf("no on-hold entries found for domain %s", domain)
+			}
+			emails = append(emails, onHoldEmails(entries)...)
+		}
+
 		if len(emails) == 0 {
-			return fmt.Errorf("provide emails via --emails or --emails-file")
+			return fmt.Errorf("provide emails via --emails, --emails-file, or --from-on-hold")
 		}
 
-		ctx := context.Background()
 		result, _, err := ms.EmailVerification.Create(ctx, &mailersend.CreateEmailVerificationOptions{
 			Name:   name,
 			Emails: emails,
@@ -474,10 +562,31 @@ var listCreateCmd = &cobra.Command{
 		}
 
 		output.Success(fmt.Sprintf("Verification list created: %s (ID: %s)", result.Data.Name, result.Data.Id))
+		if show, _ := c.Flags().GetBool("show-created"); show {
+			return output.Fields(result.Data)
+		}
 		return nil
 	},
 }
 
+// onHoldEmails returns the unique recipient addresses of on-hold entries.
+func onHoldEmails(entries []sdkclient.OnHoldEntry) []string {
+	seen := map[string]bool{}
+	var emails []string
+	for _, e := range entries {
+		email := e.Recipient.Email
+		if email == "" {
+			email = e.Pattern
+		}
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+		emails = append(emails, email)
+	}
+	return emails
+}
+
 // list verify
 var listVerifyCmd = &cobra.Command{
 	Use:   "verify <id>",
@@ -508,35 +617,43 @@ var listVerifyCmd = &cobra.Command{
 			return nil
 		}
 
-		// Poll until done
-		for {
-			time.Sleep(5 * time.Second)
+		// Stop polling cleanly on Ctrl-C instead of leaving it to the
+		// default signal handler.
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
 
-			pollResult, _, err := ms.EmailVerification.Get(ctx, id)
+		var pollResult *mailersend.SingleEmailVerificationRoot
+		var statusName string
+		interval, timeout := cmdutil.WaitFlags(c)
+		err = cmdutil.Poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+			pollResult, _, err = ms.EmailVerification.Get(ctx, id)
 			if err != nil {
-				return sdkclient.WrapError(err)
+				return false, sdkclient.WrapError(err)
 			}
-
-			statusName := ""
-			if pollResult.Data.Status.Name != "" {
-				statusName = pollResult.Data.Status.Name
+			statusName = pollResult.Data.Status.Name
+			if statusName == "verified" || statusName == "failed" {
+				return true, nil
 			}
+			output.Note(fmt.Sprintf("Waiting... (status: %s)", statusName))
+			return false, nil
+		})
+		if errors.Is(err, context.Canceled) {
+			return fmt.Errorf("interrupted while waiting for list %s; verification continues on the server", id)
+		}
+		if err != nil {
+			return err
+		}
 
-			fmt.Printf("Waiting... (status: %s)\n", statusName)
-
-			if statusName == "verified" || statusName == "failed" {
-				if cmdutil.JSONFlag(c) {
-					return output.JSON(pollResult)
-				}
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(pollResult)
+		}
 
-				if statusName == "verified" {
-					output.Success(fmt.Sprintf("Verification completed for list %s.", id))
-				} else {
-					output.Error(fmt.Sprintf("Verification failed for list %s.", id))
-				}
-				return nil
-			}
+		if statusName == "verified" {
+			output.Success(fmt.Sprintf("Verification completed for list %s.", id))
+		} else {
+			output.Error(fmt.Sprintf("Verification failed for list %s.", id))
 		}
+		return nil
 	},
 }
 
@@ -553,22 +670,43 @@ var listResultsCmd = &cobra.Command{
 
 		id := args[0]
 		limit, _ := c.Flags().GetInt("limit")
+		statuses, _ := c.Flags().GetStringSlice("status")
+		suppress, _ := c.Flags().GetString("suppress")
+		if suppress != "" && !suppressionLists[suppress] {
+			return fmt.Errorf("invalid --suppress %q: must be one of blocklist, hard-bounces, spam-complaints, unsubscribes", suppress)
+		}
+		if suppress != "" && len(statuses) == 0 {
+			return fmt.Errorf("--suppress requires --status to choose which results to add")
+		}
 
 		ctx := context.Background()
-		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Result, bool, error) {
-			root, _, err := ms.EmailVerification.GetResults(ctx, &mailersend.GetEmailVerificationOptions{
-				EmailVerificationId: id,
-				Page:                page,
-				Limit:               perPage,
-			})
-			if err != nil {
-				return nil, false, sdkclient.WrapError(err)
+		// The status filter is applied client-side, so --limit counts
+		// matching results rather than fetched ones.
+		fetchLimit := limit
+		if len(statuses) > 0 {
+			fetchLimit = 0
+		}
+		items, err := cmdutil.FetchList(ctx, c, func(ctx context.Context, page, perPage int) ([]mailersend.Result, bool, error) {
+			// Raw GET because the SDK's GetResults drops the page and limit
+			// options, so every page request would return the first page.
+			var root mailersend.ResultEmailVerificationRoot
+			path := fmt.Sprintf("/email-verification/%s/results?page=%d&limit=%d", id, page, perPage)
+			if err := sdkclient.GetJSON(ctx, ms, path, &root); err != nil {
+				return nil, false, err
 			}
 			return root.Data, root.Links.Next != "", nil
-		}, limit)
+		}, fetchLimit)
 		if err != nil {
 			return err
 		}
+		items = filterResults(items, statuses)
+		if limit > 0 && len(items) > limit {
+			items = items[:limit]
+		}
+
+		if suppress != "" {
+			return suppressResults(ctx, c, ms, suppress, items)
+		}
 
 		if cmdutil.JSONFlag(c) {
 			return output.JSON(items)
@@ -577,17 +715,166 @@ var listResultsCmd = &cobra.Command{
 		headers := []string{"EMAIL", "RESULT", "REASON"}
 		var rows [][]string
 		for _, item := range items {
-			email := item.Address
-			resultStr := item.Result
-			reason := ""
-			rows = append(rows, []string{email, resultStr, reason})
+			rows = append(rows, []string{item.Address, item.Result, resultReasons[item.Result]})
 		}
 
-		output.Table(headers, rows)
+		output.Render(headers, rows)
 		return nil
 	},
 }
 
+// list delete
+var listDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a verification list",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		ms, err := cmdutil.NewSDKClient(c)
+		if err != nil {
+			return err
+		}
+
+		id := args[0]
+		yes, _ := c.Flags().GetBool("yes")
+		if err := prompt.ConfirmDestructive(yes, fmt.Sprintf("Delete verification list %s?", id)); err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		if _, err := ms.EmailVerification.Delete(ctx, id); err != nil {
+			return sdkclient.WrapError(err)
+		}
+
+		if cmdutil.JSONFlag(c) {
+			return output.Deleted("verification_list", id)
+		}
+
+		output.Success(fmt.Sprintf("Verification list %s deleted successfully.", id))
+		return nil
+	},
+}
+
+// invalidResults are the verification results that mean the address cannot
+// receive mail; --status invalid selects all of them.
+var invalidResults = []string{"syntax_error", "typo", "mailbox_not_found", "disposable", "mailbox_blocked"}
+
+// filterResults keeps the results whose status is in statuses; an empty
+// statuses keeps everything.
+func filterResults(items []mailersend.Result, statuses []string) []mailersend.Result {
+	if len(statuses) == 0 {
+		return items
+	}
+	want := map[string]bool{}
+	for _, s := range statuses {
+		s = strings.ToLower(strings.TrimSpace(s))
+		switch s {
+		case "invalid":
+			for _, r := range invalidResults {
+				want[r] = true
+			}
+		case "role":
+			want["role_based"] = true
+		default:
+			want[s] = true
+		}
+	}
+	var kept []mailersend.Result
+	for _, item := range items {
+		if want[item.Result] {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// resultReasons explains each verification result for the REASON column;
+// the results endpoint returns only the result code.
+var resultReasons = map[string]string{
+	"valid":             "Address exists and accepts mail",
+	"catch_all":         "Domain accepts mail for any address",
+	"mailbox_full":      "Mailbox is full",
+	"role_based":        "Role address such as info@ or support@",
+	"unknown":           "Mail server did not give a definitive answer",
+	"syntax_error":      "Address is not syntactically valid",
+	"typo":              "Address looks like a typo of a common domain",
+	"mailbox_not_found": "Mailbox does not exist",
+	"disposable":        "Disposable email provider",
+	"mailbox_blocked":   "Mailbox is blocked by the provider",
+	"failed":            "Verification could not be completed",
+}
+
+// suppressionLists are the lists results can be added to with --suppress.
+var suppressionLists = map[string]bool{
+	mailersend.BlockList:      true,
+	mailersend.HardBounces:    true,
+	mailersend.SpamComplaints: true,
+	mailersend.Unsubscribes:   true,
+}
+
+// suppressResults adds the addresses of items to the named suppression list
+// of the --domain domain.
+func suppressResults(ctx context.Context, c *cobra.Command, ms *mailersend.Mailersend, list string, items []mailersend.Result) error {
+	var recipients []string
+	seen := map[string]bool{}
+	for _, item := range items {
+		if item.Address == "" || seen[item.Address] {
+			continue
+		}
+		seen[item.Address] = true
+		recipients = append(recipients, item.Address)
+	}
+	if len(recipients) == 0 {
+		output.Note("No results match --status; nothing to suppress.")
+		return nil
+	}
+
+	domain := cmdutil.DomainFlag(c)
+	domain, err := prompt.RequireArg(domain, "domain", "Domain name or ID")
+	if err != nil {
+		return err
+	}
+	domainID, err := cmdutil.ResolveDomainSDK(ms, domain)
+	if err != nil {
+		return err
+	}
+
+	// Large lists are sent in batches; a failure after the first batch
+	// reports how many addresses were already added.
+	added, err := suppressions.AddInBatches(recipients, func(batch []string) ([]string, error) {
+		opts := &mailersend.CreateSuppressionOptions{DomainID: domainID, Recipients: batch}
+		var err error
+		switch list {
+		case mailersend.BlockList:
+			_, _, err = ms.Suppression.CreateBlock(ctx, &mailersend.CreateSuppressionBlockOptions{DomainID: domainID, Recipients: batch})
+		case mailersend.HardBounces:
+			_, _, err = ms.Suppression.CreateHardBounce(ctx, opts)
+		case mailersend.SpamComplaints:
+			_, _, err = ms.Suppression.CreateSpamComplaint(ctx, opts)
+		case mailersend.Unsubscribes:
+			_, _, err = ms.Suppression.CreateUnsubscribe(ctx, opts)
+		}
+		if err != nil {
+			return nil, sdkclient.WrapError(err)
+		}
+		return batch, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if cmdutil.JSONFlag(c) {
+		return output.JSON(struct {
+			List       string   `json:"list"`
+			DomainID   string   `json:"domain_id"`
+			Added      int      `json:"added"`
+			Recipients []string `json:"recipients"`
+		}{list, domainID, len(added), added})
+	}
+
+	output.Success(fmt.Sprintf("Added %d addresses to the %s list.", len(added), list))
+	return nil
+}
+
 // --- Helpers ---
 
 // parseHTTPError creates a CLIError from a raw HTTP error response.
@@ -0,0 +1,375 @@
+package verification
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/suppressions"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{Use: "mailersend", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("profile", "", "config profile to use")
+	root.PersistentFlags().BoolP("verbose", "v", false, "show HTTP request/response details")
+	root.PersistentFlags().Bool("json", false, "output as JSON")
+	root.AddCommand(Cmd)
+	return root
+}
+
+func TestListCreateCmd_FromOnHold(t *testing.T) {
+	var onHoldQuery string
+	var createBody struct {
+		Name   string   `json:"name"`
+		Emails []string `json:"emails"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/suppressions/on-hold-list":
+			onHoldQuery = r.URL.RawQuery
+			resp := map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": "oh-1", "recipient": map[string]string{"email": "a@example.com"}},
+					{"id": "oh-2", "recipient": map[string]string{"email": "b@example.com"}},
+					{"id": "oh-3", "recipient": map[string]string{"email": "a@example.com"}},
+				},
+				"links": map[string]string{"next": ""},
+			}
+			json.NewEncoder(w).Encode(resp) //nolint:errcheck
+		case "/email-verification":
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &createBody)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": map[string]interface{}{"id": "list-1", "name": createBody.Name},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "list", "create", "--from-on-hold", "--domain", "dom-1", "--name", "On hold", "--limit", "10"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if !strings.Contains(onHoldQuery, "domain_id=dom-1") || !strings.Contains(onHoldQuery, "limit=10") {
+		t.Errorf("expected on-hold query filtered by domain and limit, got %q", onHoldQuery)
+	}
+	if createBody.Name != "On hold" {
+		t.Errorf("expected name %q, got %q", "On hold", createBody.Name)
+	}
+	want := []string{"a@example.com", "b@example.com"}
+	if len(createBody.Emails) != len(want) {
+		t.Fatalf("expected emails %v, got %v", want, createBody.Emails)
+	}
+	for i := range want {
+		if createBody.Emails[i] != want[i] {
+			t.Errorf("expected emails %v, got %v", want, createBody.Emails)
+			break
+		}
+	}
+}
+
+func TestStatusCmd_WaitPollsUntilDone(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/email-verification/verify-async/ver-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls++
+		status := "queued"
+		switch {
+		case calls == 2:
+			status = "processing"
+		case calls >= 3:
+			status = "done"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{"id": "ver-1", "address": "a@example.com", "status": status, "result": "valid"},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = statusCmd.Flags().Set("wait", "false")
+		_ = statusCmd.Flags().Set("interval", "5s")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "status", "ver-1", "--wait", "--interval", "1ms"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("status fetched %d times, want 3 (queued, processing, done)", calls)
+	}
+}
+
+func TestStatusCmd_WaitTimesOut(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"ver-1","status":"queued"}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = statusCmd.Flags().Set("wait", "false")
+		_ = statusCmd.Flags().Set("interval", "5s")
+		_ = statusCmd.Flags().Set("wait-timeout", "10m")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "status", "ver-1", "--wait", "--interval", "1ms", "--wait-timeout", "20ms"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestListDeleteCmd(t *testing.T) {
+	var gotMethod, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() { _ = listDeleteCmd.Flags().Set("yes", "false") }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "list", "delete", "list-1", "--yes"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if gotMethod != http.MethodDelete || gotPath != "/email-verification/list-1" {
+		t.Errorf("request = %s %s, want DELETE /email-verification/list-1", gotMethod, gotPath)
+	}
+}
+
+func TestListResultsCmd_SuppressBlocklist(t *testing.T) {
+	var blockBody struct {
+		DomainID   string   `json:"domain_id"`
+		Recipients []string `json:"recipients"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/email-verification/list-1/results":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data": []map[string]string{
+					{"address": "ok@example.com", "result": "valid"},
+					{"address": "gone@example.com", "result": "mailbox_not_found"},
+					{"address": "temp@example.com", "result": "disposable"},
+					{"address": "info@example.com", "result": "role_based"},
+				},
+				"links": map[string]string{"next": ""},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/suppressions/blocklist":
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &blockBody)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data":[]}`)) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = listResultsCmd.Flags().Set("suppress", "")
+		_ = listResultsCmd.Flags().Set("domain", "")
+		_ = listResultsCmd.Flags().Lookup("status").Value.(pflag.SliceValue).Replace(nil)
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "list", "results", "list-1", "--suppress", "blocklist", "--status", "invalid", "--domain", "dom-1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+
+	if blockBody.DomainID != "dom-1" {
+		t.Errorf("domain_id = %q, want dom-1", blockBody.DomainID)
+	}
+	if got := strings.Join(blockBody.Recipients, ","); got != "gone@example.com,temp@example.com" {
+		t.Errorf("recipients = %q, want only the invalid addresses", got)
+	}
+}
+
+func TestListResultsCmd_SuppressInBatches(t *testing.T) {
+	total := suppressions.MaxAddBatch + 1
+	results := make([]map[string]string, 0, total)
+	for i := 0; i < total; i++ {
+		results = append(results, map[string]string{"address": fmt.Sprintf("user%d@example.com", i), "result": "mailbox_not_found"})
+	}
+
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/email-verification/list-1/results":
+			json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+				"data":  results,
+				"links": map[string]string{"next": ""},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/suppressions/hard-bounces":
+			var body struct {
+				Recipients []string `json:"recipients"`
+			}
+			raw, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(raw, &body)
+			batches = append(batches, len(body.Recipients))
+			if len(batches) > 1 {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message":"The recipients field is invalid."}`)) //nolint:errcheck
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data":[]}`)) //nolint:errcheck
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = listResultsCmd.Flags().Set("suppress", "")
+		_ = listResultsCmd.Flags().Set("domain", "")
+		_ = listResultsCmd.Flags().Lookup("status").Value.(pflag.SliceValue).Replace(nil)
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "list", "results", "list-1", "--suppress", "hard-bounces", "--status", "invalid", "--domain", "dom-1"})
+	err := root.Execute()
+	if len(batches) != 2 || batches[0] != suppressions.MaxAddBatch || batches[1] != 1 {
+		t.Errorf("batch sizes = %v, want [%d 1]", batches, suppressions.MaxAddBatch)
+	}
+	want := fmt.Sprintf("added %d of %d before failure", suppressions.MaxAddBatch, total)
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %v, want it to contain %q", err, want)
+	}
+}
+
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestListResultsCmd_StatusFilterAndReason(t *testing.T) {
+	pages := map[string][]map[string]string{
+		"1": {{"address": "ok@example.com", "result": "valid"}, {"address": "info@example.com", "result": "role_based"}},
+		"2": {{"address": "gone@example.com", "result": "mailbox_not_found"}, {"address": "sales@example.com", "result": "role_based"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/email-verification/list-1/results" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		next := ""
+		if page == "1" {
+			next = "page2"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data":  pages[page],
+			"links": map[string]string{"next": next},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = listResultsCmd.Flags().Lookup("status").Value.(pflag.SliceValue).Replace(nil)
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "list", "results", "list-1", "--status", "role"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	for _, want := range []string{"info@example.com", "sales@example.com", "Role address"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"ok@example.com", "gone@example.com"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("output includes filtered-out %q:\n%s", unwanted, out)
+		}
+	}
+}
+
+func TestListVerifyCmd_WaitTimesOut(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"list-1","status":{"name":"verifying"}}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = listVerifyCmd.Flags().Set("wait", "false")
+		_ = listVerifyCmd.Flags().Set("interval", "5s")
+		_ = listVerifyCmd.Flags().Set("wait-timeout", "30m")
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"verification", "list", "verify", "list-1", "--wait", "--interval", "1ms", "--wait-timeout", "20ms"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
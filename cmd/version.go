@@ -1,26 +1,88 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-cli/internal/version"
 	"github.com/spf13/cobra"
 )
 
-var (
-	version = "dev"
-	commit  = "none"
-	date    = "unknown"
-)
+// updateCheckTimeout bounds the request to GitHub made by version --check.
+const updateCheckTimeout = 5 * time.Second
 
 // Version returns the version string for use by other packages (e.g. user-agent).
 func Version() string {
-	return version
+	return version.Version
 }
 
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version of mailersend",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Printf("mailersend v%s (%s) built %s\n", version, commit, date)
+	Long: `Print the version, commit, and build date of mailersend.
+
+With --check, also look up the latest release on GitHub and report whether
+a newer version is available. Set MAILERSEND_NO_UPDATE_CHECK to disable
+the check.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		check, _ := cmd.Flags().GetBool("check")
+		var latest string
+		if check {
+			if version.CheckDisabled() {
+				return fmt.Errorf("update checks are disabled by MAILERSEND_NO_UPDATE_CHECK")
+			}
+			base, err := sdkclient.ProxyTransport(cmdutil.ProxyFlag(cmd))
+			if err != nil {
+				return err
+			}
+			client := &http.Client{Timeout: updateCheckTimeout, Transport: base}
+			latest, err = version.Latest(context.Background(), client)
+			if err != nil {
+				return err
+			}
+		}
+		newer := latest != "" && version.Newer(latest, version.Version)
+
+		if cmdutil.JSONFlag(cmd) {
+			return output.JSON(struct {
+				Version         string `json:"version"`
+				Commit          string `json:"commit"`
+				Date            string `json:"date"`
+				UserAgent       string `json:"user_agent"`
+				Latest          string `json:"latest,omitempty"`
+				UpdateAvailable *bool  `json:"update_available,omitempty"`
+			}{version.Version, version.Commit, version.Date, version.UserAgent(), latest, updateAvailable(check, newer)})
+		}
+
+		fmt.Printf("mailersend v%s (%s) built %s\n", version.Version, version.Commit, version.Date)
+		switch {
+		case !check:
+		case newer:
+			fmt.Printf("A newer version is available: v%s\n", latest)
+		case latest == version.Version:
+			fmt.Println("You are on the latest release.")
+		default:
+			fmt.Printf("The latest release is v%s.\n", latest)
+		}
+		return nil
 	},
 }
+
+func init() {
+	versionCmd.Flags().Bool("check", false, "check GitHub for a newer release")
+}
+
+// updateAvailable returns the update_available JSON value: unset unless
+// --check was given.
+func updateAvailable(check, newer bool) *bool {
+	if !check {
+		return nil
+	}
+	return &newer
+}
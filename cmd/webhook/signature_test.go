@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/webhooksig"
+)
+
+func TestVerifySignatureCmd(t *testing.T) {
+	body := []byte(`{"type":"activity.sent","data":{"email":{"id":"m-1"}}}`)
+	path := filepath.Join(t.TempDir(), "payload.json")
+	if err := os.WriteFile(path, body, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		for _, name := range []string{"secret", "signature", "body"} {
+			_ = verifySignatureCmd.Flags().Set(name, "")
+		}
+	}()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"webhook", "verify-signature", "--secret", "s3cret", "--signature", webhooksig.Sign("s3cret", body), "--body", "@" + path})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("matching signature returned error: %v", err)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"webhook", "verify-signature", "--secret", "wrong", "--signature", webhooksig.Sign("s3cret", body), "--body", "@" + path})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected a mismatch error, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/config"
 	"github.com/mailersend/mailersend-cli/internal/output"
 	"github.com/mailersend/mailersend-cli/internal/prompt"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
@@ -37,7 +38,7 @@ var webhookEvents = []string{
 var Cmd = &cobra.Command{
 	Use:   "webhook",
 	Short: "Manage webhooks",
-	Long:  "List, view, create, update, and delete webhooks.",
+	Long:  "List, view, create, update, enable, disable, and delete webhooks.",
 }
 
 func init() {
@@ -45,19 +46,28 @@ func init() {
 	Cmd.AddCommand(getCmd)
 	Cmd.AddCommand(createCmd)
 	Cmd.AddCommand(updateCmd)
+	Cmd.AddCommand(enableCmd)
+	Cmd.AddCommand(disableCmd)
 	Cmd.AddCommand(deleteCmd)
 
 	// list flags
-	listCmd.Flags().String("domain", "", "domain name or ID (required)")
+	listCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	listCmd.Flags().Int("limit", 0, "maximum number of webhooks to return")
 
+	// get flags
+	getCmd.Flags().Bool("events-only", false, "print only the webhook's events, one per line (a JSON array with --json)")
+	getCmd.Flags().Bool("show-secret", false, "print the signing secret in full instead of masked")
+
 	// create flags
 	createCmd.Flags().String("name", "", "webhook name (required)")
 	createCmd.Flags().String("url", "", "webhook URL (required)")
-	createCmd.Flags().String("domain", "", "domain name or ID (required)")
+	createCmd.Flags().String("domain", "", "domain name or ID (required unless the profile has a default domain)")
 	createCmd.Flags().StringSlice("events", nil, "webhook events (required)")
 	createCmd.Flags().Bool("enabled", true, "whether the webhook is enabled")
 	createCmd.Flags().Int("version", 2, "webhook payload version (1=legacy, 2=recommended)")
+	createCmd.Flags().Bool("show-created", false, "show all fields of the created resource, including server-applied defaults")
+	createCmd.Flags().Bool("show-secret", false, "print the signing secret in full instead of masked")
+	_ = createCmd.RegisterFlagCompletionFunc("events", cmdutil.CompleteSlice(webhookEvents))
 
 	// update flags
 	updateCmd.Flags().String("name", "", "webhook name")
@@ -65,6 +75,10 @@ func init() {
 	updateCmd.Flags().StringSlice("events", nil, "webhook events")
 	updateCmd.Flags().Bool("enabled", true, "whether the webhook is enabled")
 	updateCmd.Flags().Int("version", 0, "webhook payload version (1 or 2)")
+	_ = updateCmd.RegisterFlagCompletionFunc("events", cmdutil.CompleteSlice(webhookEvents))
+
+	// delete flags
+	deleteCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt")
 }
 
 // --- list ---
@@ -82,7 +96,7 @@ func runList(c *cobra.Command, args []string) error {
 	}
 
 	limit, _ := c.Flags().GetInt("limit")
-	domainID, _ := c.Flags().GetString("domain")
+	domainID := cmdutil.DomainFlag(c)
 	domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 	if err != nil {
 		return err
@@ -120,11 +134,11 @@ func runList(c *cobra.Command, args []string) error {
 			output.Truncate(w.Name, 40),
 			output.Truncate(w.URL, 50),
 			enabled,
-			w.CreatedAt.Format(time.RFC3339),
+			output.FormatTime(w.CreatedAt, time.RFC3339),
 		})
 	}
 
-	output.Table(headers, rows)
+	output.Render(headers, rows)
 	return nil
 }
 
@@ -133,6 +147,7 @@ func runList(c *cobra.Command, args []string) error {
 var getCmd = &cobra.Command{
 	Use:   "get <webhook_id>",
 	Short: "Get webhook details",
+	Long:  "Show a webhook's settings and events. The signing secret is printed masked;\npass --show-secret to print it in full. --json output always includes it.",
 	Args:  cobra.ExactArgs(1),
 	RunE:  runGet,
 }
@@ -144,9 +159,23 @@ func runGet(c *cobra.Command, args []string) error {
 	}
 
 	ctx := context.Background()
-	result, _, err := ms.Webhook.Get(ctx, args[0])
-	if err != nil {
-		return sdkclient.WrapError(err)
+	result := new(webhookWithSecret)
+	if err := sdkclient.GetJSON(ctx, ms, "/webhooks/"+args[0], result); err != nil {
+		return err
+	}
+
+	if eventsOnly, _ := c.Flags().GetBool("events-only"); eventsOnly {
+		events := result.Data.Events
+		if events == nil {
+			events = []string{}
+		}
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(events)
+		}
+		for _, e := range events {
+			fmt.Println(e)
+		}
+		return nil
 	}
 
 	if cmdutil.JSONFlag(c) {
@@ -160,12 +189,15 @@ func runGet(c *cobra.Command, args []string) error {
 		enabled = "Yes"
 	}
 
-	fmt.Printf("ID:           %s\n", d.ID)
-	fmt.Printf("Name:         %s\n", d.Name)
-	fmt.Printf("URL:          %s\n", d.URL)
-	fmt.Printf("Enabled:      %s\n", enabled)
-	fmt.Printf("Created At:   %s\n", d.CreatedAt.Format(time.RFC3339))
-	fmt.Printf("Updated At:   %s\n", d.UpdatedAt.Format(time.RFC3339))
+	fmt.Printf("ID:             %s\n", d.ID)
+	fmt.Printf("Name:           %s\n", d.Name)
+	fmt.Printf("URL:            %s\n", d.URL)
+	fmt.Printf("Enabled:        %s\n", enabled)
+	if d.Secret != "" {
+		fmt.Printf("Signing Secret: %s\n", displaySecret(c, d.Secret))
+	}
+	fmt.Printf("Created At:     %s\n", output.FormatTime(d.CreatedAt, time.RFC3339))
+	fmt.Printf("Updated At:     %s\n", output.FormatTime(d.UpdatedAt, time.RFC3339))
 
 	fmt.Println()
 	fmt.Println("Events:")
@@ -181,8 +213,28 @@ func runGet(c *cobra.Command, args []string) error {
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a webhook",
-	Long:  "Create a new webhook.\n\nValid events: " + strings.Join(webhookEvents, ", "),
-	RunE:  runCreate,
+	Long: "Create a new webhook.\n\nThe signing secret used to verify webhook signatures is printed masked;\n" +
+		"pass --show-secret to print it in full. --json output always includes it.\n\n" +
+		"Valid events: " + strings.Join(webhookEvents, ", "),
+	RunE: runCreate,
+}
+
+// webhookWithSecret is the get and create response. The SDK's Webhook type
+// drops the signing secret, so those requests are sent raw to keep it.
+type webhookWithSecret struct {
+	Data struct {
+		mailersend.Webhook
+		Secret string `json:"secret"`
+	} `json:"data"`
+}
+
+// displaySecret returns the signing secret for display: in full with
+// --show-secret, otherwise masked like the API token in auth status.
+func displaySecret(c *cobra.Command, secret string) string {
+	if show, _ := c.Flags().GetBool("show-secret"); show {
+		return secret
+	}
+	return config.MaskToken(secret) + " (pass --show-secret to print it in full)"
 }
 
 func runCreate(c *cobra.Command, args []string) error {
@@ -201,7 +253,7 @@ func runCreate(c *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	domainID, _ := c.Flags().GetString("domain")
+	domainID := cmdutil.DomainFlag(c)
 	domainID, err = prompt.RequireArg(domainID, "domain", "Domain name or ID")
 	if err != nil {
 		return err
@@ -228,9 +280,9 @@ func runCreate(c *cobra.Command, args []string) error {
 		Version:  mailersend.Int(version),
 	}
 
-	result, _, err := ms.Webhook.Create(ctx, opts)
-	if err != nil {
-		return sdkclient.WrapError(err)
+	result := new(webhookWithSecret)
+	if err := sdkclient.PostJSON(ctx, ms, "/webhooks", opts, result); err != nil {
+		return err
 	}
 
 	if cmdutil.JSONFlag(c) {
@@ -238,6 +290,12 @@ func runCreate(c *cobra.Command, args []string) error {
 	}
 
 	output.Success("Webhook created successfully. ID: " + result.Data.ID)
+	if secret := result.Data.Secret; secret != "" {
+		fmt.Println("Signing secret: " + displaySecret(c, secret))
+	}
+	if show, _ := c.Flags().GetBool("show-created"); show {
+		return output.Fields(result.Data)
+	}
 	return nil
 }
 
@@ -252,6 +310,21 @@ var updateCmd = &cobra.Command{
 }
 
 func runUpdate(c *cobra.Command, args []string) error {
+	// An unset --events leaves the webhook's events unchanged; an explicitly
+	// empty one would clear them, which the API does not allow.
+	var events []string
+	if c.Flags().Changed("events") {
+		raw, _ := c.Flags().GetStringSlice("events")
+		for _, e := range raw {
+			if e = strings.TrimSpace(e); e != "" {
+				events = append(events, e)
+			}
+		}
+		if len(events) == 0 {
+			return fmt.Errorf("--events cannot be empty: a webhook needs at least one event (omit --events to keep the current ones)")
+		}
+	}
+
 	ms, err := cmdutil.NewSDKClient(c)
 	if err != nil {
 		return err
@@ -269,8 +342,7 @@ func runUpdate(c *cobra.Command, args []string) error {
 		url, _ := c.Flags().GetString("url")
 		opts.URL = url
 	}
-	if c.Flags().Changed("events") {
-		events, _ := c.Flags().GetStringSlice("events")
+	if events != nil {
 		opts.Events = events
 	}
 	if c.Flags().Changed("enabled") {
@@ -296,6 +368,68 @@ func runUpdate(c *cobra.Command, args []string) error {
 	return nil
 }
 
+// --- enable / disable ---
+
+var enableCmd = &cobra.Command{
+	Use:   "enable <webhook_id>",
+	Short: "Enable a webhook",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return setEnabled(c, args[0], true)
+	},
+}
+
+var disableCmd = &cobra.Command{
+	Use:   "disable <webhook_id>",
+	Short: "Disable a webhook",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return setEnabled(c, args[0], false)
+	},
+}
+
+// setEnabled fetches the webhook and, unless it is already in that state,
+// updates only its enabled field.
+func setEnabled(c *cobra.Command, id string, enabled bool) error {
+	ms, err := cmdutil.NewSDKClient(c)
+	if err != nil {
+		return err
+	}
+
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+
+	ctx := context.Background()
+	current, _, err := ms.Webhook.Get(ctx, id)
+	if err != nil {
+		return sdkclient.WrapError(err)
+	}
+	if current.Data.Enabled == enabled {
+		if cmdutil.JSONFlag(c) {
+			return output.JSON(current)
+		}
+		output.Note("Webhook " + id + " is already " + state + ".")
+		return nil
+	}
+
+	result, _, err := ms.Webhook.Update(ctx, &mailersend.UpdateWebhookOptions{
+		WebhookID: id,
+		Enabled:   mailersend.Bool(enabled),
+	})
+	if err != nil {
+		return sdkclient.WrapError(err)
+	}
+
+	if cmdutil.JSONFlag(c) {
+		return output.JSON(result)
+	}
+
+	output.Success("Webhook " + id + " " + state + ".")
+	return nil
+}
+
 // --- delete ---
 
 var deleteCmd = &cobra.Command{
@@ -311,12 +445,21 @@ func runDelete(c *cobra.Command, args []string) error {
 		return err
 	}
 
+	yes, _ := c.Flags().GetBool("yes")
+	if err := prompt.ConfirmDestructive(yes, fmt.Sprintf("Delete webhook %s?", args[0])); err != nil {
+		return err
+	}
+
 	ctx := context.Background()
 	_, err = ms.Webhook.Delete(ctx, args[0])
 	if err != nil {
 		return sdkclient.WrapError(err)
 	}
 
+	if cmdutil.JSONFlag(c) {
+		return output.Deleted("webhook", args[0])
+	}
+
 	output.Success("Webhook " + args[0] + " deleted successfully.")
 	return nil
 }
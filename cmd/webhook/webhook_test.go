@@ -3,8 +3,11 @@ package webhook
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -123,3 +126,293 @@ func TestWebhookListCmd_MockServer(t *testing.T) {
 		t.Error("expected domain_id in query string")
 	}
 }
+
+func TestWebhookUpdateCmd_Events(t *testing.T) {
+	var requests int
+	var putBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		putBody = nil
+		_ = json.NewDecoder(r.Body).Decode(&putBody)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{"id": "wh-1", "name": "Renamed"},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() { updateCmd.Flags().Lookup("events").Changed = false }()
+
+	// Unset --events leaves events untouched.
+	root := newRootCmd()
+	root.SetArgs([]string{"webhook", "update", "wh-1", "--name", "Renamed"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("command returned error: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+	if _, ok := putBody["events"]; ok {
+		t.Errorf("expected no events in body when flag unset, got %v", putBody["events"])
+	}
+
+	// An explicitly empty --events is rejected before any request.
+	root = newRootCmd()
+	root.SetArgs([]string{"webhook", "update", "wh-1", "--events", ""})
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("expected error for empty --events")
+	}
+	if !strings.Contains(err.Error(), "--events cannot be empty") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected no request for empty --events, got %d total", requests)
+	}
+}
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+func TestWebhookGetCmd_EventsOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/wh-1" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"id":      "wh-1",
+				"url":     "https://example.com/hook",
+				"events":  []string{"activity.sent", "activity.delivered"},
+				"name":    "Test Webhook",
+				"enabled": true,
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() { _ = getCmd.Flags().Set("events-only", "false") }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"webhook", "get", "wh-1", "--events-only"})
+
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if out != "activity.sent\nactivity.delivered\n" {
+		t.Errorf("expected only events, one per line, got %q", out)
+	}
+}
+
+func TestWebhookDeleteCmd_JSONShape(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/webhooks/wh-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs([]string{"webhook", "delete", "wh-1", "--yes"})
+	defer deleteCmd.Flags().Set("yes", "false") //nolint:errcheck
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if got, want := strings.TrimSpace(out), "{\n  \"status\": \"deleted\",\n  \"id\": \"wh-1\",\n  \"resource\": \"webhook\"\n}"; got != want {
+		t.Errorf("output = %s, want %s", got, want)
+	}
+}
+
+func TestWebhookCreateCmd_CapturesSecret(t *testing.T) {
+	const secret = "Xq8bT2mZ4rLkP9sVn3wYc7dF1gHj5aE0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/webhooks" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck
+		if body["domain_id"] != "dom-1" || body["url"] != "https://example.com/hook" {
+			t.Errorf("unexpected create payload: %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"id":      "wh-1",
+				"url":     "https://example.com/hook",
+				"events":  []string{"activity.sent"},
+				"name":    "Hook",
+				"enabled": true,
+				"secret":  secret,
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() {
+		_ = createCmd.Flags().Set("show-secret", "false")
+		_ = createCmd.Flags().Set("events", "")
+	}()
+
+	args := []string{"webhook", "create", "--name", "Hook", "--url", "https://example.com/hook", "--domain", "dom-1", "--events", "activity.sent"}
+	root := newRootCmd()
+	root.SetArgs(args)
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	if strings.Contains(out, secret) || !strings.Contains(out, "Signing secret: Xq8bT2m...5aE0") {
+		t.Errorf("expected the secret masked by default, got:\n%s", out)
+	}
+
+	root = newRootCmd()
+	root.SetArgs(append(args, "--show-secret"))
+	out = captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Signing secret: "+secret) {
+		t.Errorf("expected the full secret with --show-secret, got:\n%s", out)
+	}
+
+	root = newRootCmd()
+	if err := root.PersistentFlags().Set("json", "true"); err != nil {
+		t.Fatal(err)
+	}
+	root.SetArgs(args)
+	out = captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	var parsed struct {
+		Data struct {
+			ID     string `json:"id"`
+			Secret string `json:"secret"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if parsed.Data.ID != "wh-1" || parsed.Data.Secret != secret {
+		t.Errorf("JSON output = %+v, want the ID and full secret", parsed.Data)
+	}
+}
+
+func TestWebhookDisableCmd_OnlyEnabledChanges(t *testing.T) {
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/wh-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			json.NewDecoder(r.Body).Decode(&putBody) //nolint:errcheck
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{
+				"id":      "wh-1",
+				"url":     "https://example.com/hook",
+				"events":  []string{"activity.sent"},
+				"name":    "Hook",
+				"enabled": putBody == nil,
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+
+	root := newRootCmd()
+	root.SetArgs([]string{"webhook", "disable", "wh-1"})
+	captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+
+	if len(putBody) != 1 || putBody["enabled"] != false {
+		t.Errorf("PUT body = %v, want only enabled=false", putBody)
+	}
+}
+
+func TestWebhookGetCmd_MasksSecret(t *testing.T) {
+	const secret = "whsec_0123456789abcdefghij"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck
+			"data": map[string]interface{}{"id": "wh-1", "name": "Test Webhook", "secret": secret},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", server.URL)
+	defer func() { _ = getCmd.Flags().Set("show-secret", "false") }()
+
+	root := newRootCmd()
+	root.SetArgs([]string{"webhook", "get", "wh-1"})
+	out := captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	if strings.Contains(out, secret) || !strings.Contains(out, "Signing Secret: whsec_0...ghij") {
+		t.Errorf("expected the secret masked, got:\n%s", out)
+	}
+
+	root = newRootCmd()
+	root.SetArgs([]string{"webhook", "get", "wh-1", "--show-secret"})
+	out = captureStdout(t, func() {
+		if err := root.Execute(); err != nil {
+			t.Fatalf("command returned error: %v", err)
+		}
+	})
+	if !strings.Contains(out, "Signing Secret: "+secret) {
+		t.Errorf("expected the secret in full with --show-secret, got:\n%s", out)
+	}
+}
@@ -0,0 +1,113 @@
+// Package analytics renders analytics by date for "analytics date" and the
+// per-resource shortcuts built on it, such as "domain analytics".
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-go"
+	"github.com/spf13/cobra"
+)
+
+// RenderDate fetches analytics by date for opts and prints them as a table,
+// JSON under --json, or a time series when the command has --series set.
+func RenderDate(cobraCmd *cobra.Command, ms *mailersend.Mailersend, opts *mailersend.AnalyticsOptions) error {
+	events := opts.Event
+
+	ctx := context.Background()
+	result, _, err := ms.Analytics.GetActivityByDate(ctx, opts)
+	if err != nil {
+		return sdkclient.WrapError(err)
+	}
+
+	if series, _ := cobraCmd.Flags().GetBool("series"); series {
+		return output.JSON(buildSeries(result.Data.Stats, events))
+	}
+
+	if cmdutil.JSONFlag(cobraCmd) {
+		return output.JSON(result)
+	}
+
+	if len(result.Data.Stats) == 0 {
+		NoteEmptyRange(opts)
+	}
+
+	headers := []string{"DATE"}
+	for _, e := range events {
+		headers = append(headers, strings.ToUpper(e))
+	}
+
+	var rows [][]string
+	for _, stat := range result.Data.Stats {
+		row := []string{stat.Date}
+		for _, e := range events {
+			row = append(row, fmt.Sprintf("%d", statValue(stat, e)))
+		}
+		rows = append(rows, row)
+	}
+
+	output.Render(headers, rows)
+	return nil
+}
+
+// seriesPoint is a single date/count sample in an --series time series.
+type seriesPoint struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+// buildSeries pivots row-oriented stats into one time series per event, the
+// shape most charting libraries expect.
+func buildSeries(stats []mailersend.AnalyticsStats, events []string) map[string][]seriesPoint {
+	series := make(map[string][]seriesPoint, len(events))
+	for _, e := range events {
+		points := make([]seriesPoint, 0, len(stats))
+		for _, stat := range stats {
+			points = append(points, seriesPoint{Date: stat.Date, Count: statValue(stat, e)})
+		}
+		series[e] = points
+	}
+	return series
+}
+
+// statValue extracts a named stat field from AnalyticsStats by event name.
+func statValue(s mailersend.AnalyticsStats, event string) int {
+	switch event {
+	case "queued":
+		return s.Queued
+	case "sent":
+		return s.Sent
+	case "delivered":
+		return s.Delivered
+	case "soft_bounced":
+		return s.SoftBounced
+	case "hard_bounced":
+		return s.HardBounced
+	case "junk":
+		return s.Junk
+	case "opened":
+		return s.Opened
+	case "clicked":
+		return s.Clicked
+	case "unsubscribed":
+		return s.Unsubscribed
+	case "spam_complaints":
+		return s.SpamComplaints
+	default:
+		return 0
+	}
+}
+
+// NoteEmptyRange tells the user why an analytics table is empty, echoing
+// the resolved date range so that defaults and typos are easy to spot.
+func NoteEmptyRange(opts *mailersend.AnalyticsOptions) {
+	const layout = "2006-01-02 15:04"
+	output.Note(fmt.Sprintf("No analytics data between %s and %s.",
+		time.Unix(opts.DateFrom, 0).Format(layout), time.Unix(opts.DateTo, 0).Format(layout)))
+}
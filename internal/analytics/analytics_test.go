@@ -0,0 +1,50 @@
+package analytics
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mailersend/mailersend-go"
+)
+
+func TestBuildSeries_KeyedByEvent(t *testing.T) {
+	stats := []mailersend.AnalyticsStats{
+		{Date: "2024-01-01", Sent: 10, Delivered: 9},
+		{Date: "2024-01-02", Sent: 20, Delivered: 18},
+	}
+
+	series := buildSeries(stats, []string{"sent", "delivered"})
+
+	if len(series) != 2 {
+		t.Fatalf("expected 2 series, got %d", len(series))
+	}
+
+	want := map[string][]seriesPoint{
+		"sent":      {{Date: "2024-01-01", Count: 10}, {Date: "2024-01-02", Count: 20}},
+		"delivered": {{Date: "2024-01-01", Count: 9}, {Date: "2024-01-02", Count: 18}},
+	}
+	for event, points := range want {
+		got := series[event]
+		if len(got) != len(points) {
+			t.Fatalf("%s: expected %d points, got %d", event, len(points), len(got))
+		}
+		for i := range points {
+			if got[i] != points[i] {
+				t.Errorf("%s[%d]: expected %+v, got %+v", event, i, points[i], got[i])
+			}
+		}
+	}
+
+	// JSON shape is {event: [{date, count}]}.
+	raw, err := json.Marshal(series)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	var parsed map[string][]map[string]interface{}
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		t.Fatalf("unexpected JSON shape: %v\n%s", err, raw)
+	}
+	if parsed["sent"][1]["date"] != "2024-01-02" || parsed["sent"][1]["count"] != float64(20) {
+		t.Errorf("unexpected sent[1]: %v", parsed["sent"][1])
+	}
+}
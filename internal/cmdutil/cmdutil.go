@@ -15,10 +15,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// ProfileFlag returns the --profile persistent flag value.
+// ProfileFlag returns the --profile persistent flag value, or
+// MAILERSEND_PROFILE when the flag is not given.
 func ProfileFlag(cmd *cobra.Command) string {
-	v, _ := cmd.Root().PersistentFlags().GetString("profile")
-	return v
+	if v, _ := cmd.Root().PersistentFlags().GetString("profile"); v != "" {
+		return v
+	}
+	return os.Getenv(config.ProfileEnvVar)
 }
 
 // VerboseFlag returns the --verbose persistent flag value.
@@ -27,15 +30,155 @@ func VerboseFlag(cmd *cobra.Command) bool {
 	return v
 }
 
-// JSONFlag returns the --json persistent flag value.
+// JSONFlag reports whether structured output was requested: --json,
+// --jsonpath, or --output json or yaml. Commands that see it print their
+// data with output.JSON, which renders YAML under --output yaml.
 func JSONFlag(cmd *cobra.Command) bool {
 	v, _ := cmd.Root().PersistentFlags().GetBool("json")
+	if f := OutputFlag(cmd); f == "json" || f == "yaml" {
+		return true
+	}
+	return v || JSONPathFlag(cmd) != ""
+}
+
+// OutputFlag returns the --output persistent flag value.
+func OutputFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("output")
+	return v
+}
+
+// JSONPathFlag returns the --jsonpath persistent flag value. Setting it
+// implies --json.
+func JSONPathFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("jsonpath")
+	return v
+}
+
+// QuietFlag returns the --quiet persistent flag value.
+func QuietFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("quiet")
+	return v
+}
+
+// NoCacheFlag returns the --no-cache persistent flag value.
+func NoCacheFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("no-cache")
+	return v
+}
+
+// DefaultTimeout is the HTTP client timeout used when --timeout is not set.
+const DefaultTimeout = 30 * time.Second
+
+// TimeoutFlag returns the --timeout persistent flag value, the HTTP client
+// timeout for each API request, or DefaultTimeout if the flag is not
+// registered.
+func TimeoutFlag(cmd *cobra.Command) time.Duration {
+	v, err := cmd.Root().PersistentFlags().GetDuration("timeout")
+	if err != nil {
+		return DefaultTimeout
+	}
+	return v
+}
+
+// ProxyFlag returns the --proxy persistent flag value.
+func ProxyFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("proxy")
+	return v
+}
+
+// SequentialFlag returns the --sequential persistent flag value.
+func SequentialFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("sequential")
+	return v
+}
+
+// PagerFlag returns the --pager persistent flag value.
+func PagerFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("pager")
+	return v
+}
+
+// OutputFileFlag returns the --output-file persistent flag value.
+func OutputFileFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("output-file")
+	return v
+}
+
+// StripColorFlag returns the --strip-color persistent flag value.
+func StripColorFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("strip-color")
+	return v
+}
+
+// RelativeTimeFlag returns the --relative-time persistent flag value.
+func RelativeTimeFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("relative-time")
+	return v
+}
+
+// ColorFlag returns the --color persistent flag value.
+func ColorFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("color")
+	return v
+}
+
+// TimeFormatFlag returns the --time-format persistent flag value.
+func TimeFormatFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("time-format")
+	return v
+}
+
+// IndentFlag returns the --indent persistent flag value.
+func IndentFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("indent")
+	return v
+}
+
+// ConfigFlag returns the --config persistent flag value.
+func ConfigFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("config")
 	return v
 }
 
-// SetVersion configures the SDK client user-agent with the CLI version.
-func SetVersion(v string) {
-	sdkclient.SetUserAgent("mailersend-cli/" + v)
+// TokenFileFlag returns the --token-file persistent flag value.
+func TokenFileFlag(cmd *cobra.Command) string {
+	v, _ := cmd.Root().PersistentFlags().GetString("token-file")
+	return v
+}
+
+// JSONCompactFlag returns the --json-compact persistent flag value.
+func JSONCompactFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("json-compact")
+	return v
+}
+
+// FieldsFlag returns the --fields persistent flag value.
+func FieldsFlag(cmd *cobra.Command) []string {
+	v, _ := cmd.Root().PersistentFlags().GetStringSlice("fields")
+	return v
+}
+
+// IDsOnlyFlags returns the --ids-only and --print0 persistent flag values.
+func IDsOnlyFlags(cmd *cobra.Command) (idsOnly, print0 bool) {
+	idsOnly, _ = cmd.Root().PersistentFlags().GetBool("ids-only")
+	print0, _ = cmd.Root().PersistentFlags().GetBool("print0")
+	return idsOnly, print0
+}
+
+// EnvelopeFlag returns the --envelope persistent flag value.
+func EnvelopeFlag(cmd *cobra.Command) bool {
+	v, _ := cmd.Root().PersistentFlags().GetBool("envelope")
+	return v
+}
+
+// NewHTTPClient returns a client for requests outside the API, such as the
+// OAuth endpoints, that honors --proxy and --timeout like NewSDKClient.
+func NewHTTPClient(cmd *cobra.Command) (*http.Client, error) {
+	base, err := sdkclient.ProxyTransport(ProxyFlag(cmd))
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Timeout: TimeoutFlag(cmd), Transport: base}, nil
 }
 
 // NewSDKClient creates a mailersend-go SDK client with CLI-specific behavior
@@ -46,32 +189,75 @@ func NewSDKClient(cmd *cobra.Command) (*mailersend.Mailersend, error) {
 		return nil, err
 	}
 
+	base, err := sdkclient.ProxyTransport(ProxyFlag(cmd))
+	if err != nil {
+		return nil, err
+	}
+
 	transport := &sdkclient.CLITransport{
-		Base:    http.DefaultTransport,
+		Base:    base,
 		Verbose: VerboseFlag(cmd),
 	}
 
-	if base := os.Getenv("MAILERSEND_API_BASE_URL"); base != "" {
+	if base := baseURL(); base != "" {
 		transport.BaseURL = base
 	}
 
 	ms := mailersend.NewMailersend(token)
 	ms.SetClient(&http.Client{
-		Timeout:   30 * time.Second,
+		Timeout:   TimeoutFlag(cmd),
 		Transport: transport,
 	})
+	// Unregistered clients bypass the domain cache.
+	if !NoCacheFlag(cmd) {
+		registerClientScope(ms, cacheScope(ProfileFlag(cmd)))
+	}
 
 	return ms, nil
 }
 
-// ResolveDomainSDK takes a value that is either a domain ID or a domain name
-// (hostname). If it contains a dot, it's treated as a hostname and resolved
-// to a domain ID by listing domains from the API. Otherwise it's returned as-is.
-func ResolveDomainSDK(ms *mailersend.Mailersend, idOrName string) (string, error) {
-	if !strings.Contains(idOrName, ".") {
-		return idOrName, nil
+// baseURL returns the API base URL override: MAILERSEND_API_BASE_URL if set,
+// otherwise the base_url config key. An empty result means the SDK default.
+func baseURL() string {
+	if base := os.Getenv("MAILERSEND_API_BASE_URL"); base != "" {
+		return base
 	}
+	if cfg, err := config.Load(); err == nil {
+		return cfg.BaseURL
+	}
+	return ""
+}
 
+// DomainFlag returns the command's --domain flag value, falling back to the
+// default_domain of the selected profile when the flag is not given and the
+// token comes from that profile. It is for commands that require a domain;
+// optional domain filters read the flag directly so that a default does not
+// silently narrow their results.
+func DomainFlag(cmd *cobra.Command) string {
+	if v, _ := cmd.Flags().GetString("domain"); v != "" {
+		return v
+	}
+	// A token from --token-file or the environment may belong to another
+	// account, where the profile's domain does not exist.
+	if config.EnvTokenSet() {
+		return ""
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return ""
+	}
+	if name := ProfileFlag(cmd); name != "" {
+		return cfg.Profiles[name].DefaultDomain
+	}
+	if _, prof, err := config.ActiveProfile(cfg); err == nil {
+		return prof.DefaultDomain
+	}
+	return ""
+}
+
+// listAllDomains fetches every domain in the account and refreshes the
+// resolution cache with the result.
+func listAllDomains(ms *mailersend.Mailersend) ([]mailersend.Domain, error) {
 	ctx := context.Background()
 	domains, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
 		root, _, err := ms.Domain.List(ctx, &mailersend.ListDomainOptions{Page: page, Limit: perPage})
@@ -81,7 +267,28 @@ func ResolveDomainSDK(ms *mailersend.Mailersend, idOrName string) (string, error
 		return root.Data, root.Links.Next != "", nil
 	}, 0)
 	if err != nil {
-		return "", fmt.Errorf("failed to list domains for resolution: %w", err)
+		return nil, fmt.Errorf("failed to list domains for resolution: %w", err)
+	}
+	storeDomains(ms, domains)
+	return domains, nil
+}
+
+// ResolveDomainSDK takes a value that is either a domain ID or a domain name
+// (hostname). If it contains a dot, it's treated as a hostname and resolved
+// to a domain ID by listing domains from the API, unless a fresh mapping is in
+// the on-disk resolution cache. Otherwise it's returned as-is.
+func ResolveDomainSDK(ms *mailersend.Mailersend, idOrName string) (string, error) {
+	if !strings.Contains(idOrName, ".") {
+		return idOrName, nil
+	}
+
+	if id, ok := cachedDomainID(ms, idOrName); ok {
+		return id, nil
+	}
+
+	domains, err := listAllDomains(ms)
+	if err != nil {
+		return "", err
 	}
 
 	for _, d := range domains {
@@ -102,16 +309,13 @@ func ResolveDomainNameSDK(ms *mailersend.Mailersend, idOrName string) (string, e
 		return idOrName, nil
 	}
 
-	ctx := context.Background()
-	domains, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
-		root, _, err := ms.Domain.List(ctx, &mailersend.ListDomainOptions{Page: page, Limit: perPage})
-		if err != nil {
-			return nil, false, sdkclient.WrapError(err)
-		}
-		return root.Data, root.Links.Next != "", nil
-	}, 0)
+	if name, ok := cachedDomainName(ms, idOrName); ok {
+		return name, nil
+	}
+
+	domains, err := listAllDomains(ms)
 	if err != nil {
-		return "", fmt.Errorf("failed to list domains for resolution: %w", err)
+		return "", err
 	}
 
 	for _, d := range domains {
@@ -137,6 +341,30 @@ func ParseDate(value string) (int64, error) {
 	return ts, nil
 }
 
+// ParseSince accepts a relative duration such as "-7d", "7d", "2w", or
+// "24h" (the sign is optional; durations always count back from now), or
+// anything ParseDate accepts, and returns the corresponding unix timestamp.
+func ParseSince(value string, now time.Time) (int64, error) {
+	rel := strings.TrimPrefix(value, "-")
+	if len(rel) >= 2 {
+		if n, err := strconv.Atoi(rel[:len(rel)-1]); err == nil && n >= 0 {
+			switch rel[len(rel)-1] {
+			case 'h':
+				return now.Add(-time.Duration(n) * time.Hour).Unix(), nil
+			case 'd':
+				return now.AddDate(0, 0, -n).Unix(), nil
+			case 'w':
+				return now.AddDate(0, 0, -7*n).Unix(), nil
+			}
+		}
+	}
+	ts, err := ParseDate(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --since %q: use a relative duration like -7d, 2w, or 24h, or a date", value)
+	}
+	return ts, nil
+}
+
 // DefaultDateRange returns parsed dateFrom/dateTo timestamps. If either value
 // is empty, it defaults to the last 7 days (dateTo = now, dateFrom = now - 7d).
 func DefaultDateRange(dateFromStr, dateToStr string, now time.Time) (int64, int64, error) {
@@ -1,14 +1,18 @@
 package cmdutil
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/mailersend/mailersend-cli/internal/config"
 	"github.com/mailersend/mailersend-cli/internal/sdkclient"
 	"github.com/mailersend/mailersend-go"
+	"github.com/spf13/cobra"
 )
 
 // domainListResponse builds a JSON paginated response containing the given domains.
@@ -160,3 +164,396 @@ func TestResolveDomainSDK_CaseInsensitiveMatch(t *testing.T) {
 		t.Fatalf("expected %q, got %q", "domain-upper", got)
 	}
 }
+
+// ---------- Domain resolution cache ----------
+
+func TestResolveDomainSDK_CacheHitWithinTTLSkipsAPI(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(domainListResponse([]map[string]string{ //nolint:errcheck
+			{"id": "domain-1", "name": "example.com"},
+		}))
+	}
+
+	// Two separate clients simulate two CLI invocations for one profile.
+	first, _ := newTestSDKClient(handler)
+	registerClientScope(first, "test")
+	second, _ := newTestSDKClient(handler)
+	registerClientScope(second, "test")
+
+	if _, err := ResolveDomainSDK(first, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := ResolveDomainSDK(second, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "domain-1" {
+		t.Fatalf("expected %q, got %q", "domain-1", got)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 API call, got %d", calls)
+	}
+
+	// Reverse lookups are served from the same entries.
+	name, err := ResolveDomainNameSDK(second, "domain-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "example.com" || calls != 1 {
+		t.Fatalf("expected cached name example.com with 1 API call, got %q with %d", name, calls)
+	}
+}
+
+func TestResolveDomainSDK_CacheMissAfterExpiry(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	start := time.Now()
+	nowFunc = func() time.Time { return start }
+	defer func() { nowFunc = time.Now }()
+
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(domainListResponse([]map[string]string{ //nolint:errcheck
+			{"id": "domain-1", "name": "example.com"},
+		}))
+	}
+	ms, _ := newTestSDKClient(handler)
+	registerClientScope(ms, "test")
+
+	if _, err := ResolveDomainSDK(ms, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nowFunc = func() time.Time { return start.Add(domainCacheTTL + time.Second) }
+
+	if _, err := ResolveDomainSDK(ms, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 API calls after TTL expiry, got %d", calls)
+	}
+}
+
+func TestInvalidateDomainCache_ForcesRefresh(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(domainListResponse([]map[string]string{ //nolint:errcheck
+			{"id": "domain-1", "name": "example.com"},
+		}))
+	}
+	ms, _ := newTestSDKClient(handler)
+	registerClientScope(ms, "test")
+
+	if _, err := ResolveDomainSDK(ms, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	InvalidateDomainCache(ms)
+	if _, err := ResolveDomainSDK(ms, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 API calls after invalidation, got %d", calls)
+	}
+}
+
+func TestClearDomainCache_ForcesRefresh(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(domainListResponse([]map[string]string{ //nolint:errcheck
+			{"id": "domain-1", "name": "example.com"},
+		}))
+	}
+	ms, _ := newTestSDKClient(handler)
+	registerClientScope(ms, "test")
+
+	if _, err := ResolveDomainSDK(ms, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ClearDomainCache(); err != nil {
+		t.Fatalf("ClearDomainCache() error: %v", err)
+	}
+	if _, err := ResolveDomainSDK(ms, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 API calls after clearing the cache, got %d", calls)
+	}
+
+	// Clearing a cache that does not exist is not an error.
+	if err := ClearDomainCache(); err != nil {
+		t.Fatalf("ClearDomainCache() on a missing file: %v", err)
+	}
+}
+
+func TestNewSDKClient_NoCacheBypassesDomainCache(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+
+	root := &cobra.Command{Use: "mailersend"}
+	root.PersistentFlags().String("profile", "", "")
+	root.PersistentFlags().Bool("verbose", false, "")
+	root.PersistentFlags().Bool("no-cache", false, "")
+
+	ms, err := NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := lookupClientScope(ms); !ok {
+		t.Error("expected the client to use the domain cache by default")
+	}
+
+	if err := root.PersistentFlags().Set("no-cache", "true"); err != nil {
+		t.Fatal(err)
+	}
+	ms, err = NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := lookupClientScope(ms); ok {
+		t.Error("expected --no-cache to bypass the domain cache")
+	}
+}
+
+func TestNewSDKClient_TimeoutFlag(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+
+	root := &cobra.Command{Use: "mailersend"}
+	root.PersistentFlags().String("profile", "", "")
+	root.PersistentFlags().Bool("verbose", false, "")
+
+	ms, err := NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ms.Client().Timeout; got != DefaultTimeout {
+		t.Errorf("timeout without --timeout = %s, want %s", got, DefaultTimeout)
+	}
+
+	root.PersistentFlags().Duration("timeout", DefaultTimeout, "")
+	if err := root.PersistentFlags().Set("timeout", "2m"); err != nil {
+		t.Fatal(err)
+	}
+	ms, err = NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ms.Client().Timeout; got != 2*time.Minute {
+		t.Errorf("timeout with --timeout 2m = %s, want 2m0s", got)
+	}
+}
+
+func TestNewSDKClient_ProxyFlag(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute target URL.
+		proxied = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": []}`)) //nolint:errcheck
+	}))
+	defer proxy.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	t.Setenv("MAILERSEND_API_BASE_URL", "http://api.mailersend.invalid/v1")
+
+	root := &cobra.Command{Use: "mailersend"}
+	root.PersistentFlags().String("profile", "", "")
+	root.PersistentFlags().Bool("verbose", false, "")
+	root.PersistentFlags().String("proxy", "", "")
+	if err := root.PersistentFlags().Set("proxy", proxy.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	ms, err := NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := ms.Domain.List(context.Background(), &mailersend.ListDomainOptions{}); err != nil {
+		t.Fatalf("request through proxy failed: %v", err)
+	}
+	if !strings.HasPrefix(proxied, "http://") || !strings.Contains(proxied, "/v1/domains") {
+		t.Errorf("proxy saw %q, want the absolute API URL", proxied)
+	}
+
+	if err := root.PersistentFlags().Set("proxy", "not a url"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSDKClient(root); err == nil {
+		t.Error("expected an invalid --proxy to be rejected")
+	}
+}
+
+func TestProfileFlag_EnvDefault(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "")
+	cfg := &config.Config{
+		ActiveProfile: "personal",
+		Profiles: map[string]config.Profile{
+			"personal": {APIToken: "token-personal"},
+			"staging":  {APIToken: "token-staging"},
+			"prod":     {APIToken: "token-prod"},
+		},
+	}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(config.ProfileEnvVar, "staging")
+
+	root := &cobra.Command{Use: "mailersend"}
+	root.PersistentFlags().String("profile", "", "")
+	root.PersistentFlags().Bool("verbose", false, "")
+
+	ms, err := NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ms.APIKey(); got != "token-staging" {
+		t.Errorf("with MAILERSEND_PROFILE=staging got token %q, want token-staging", got)
+	}
+
+	if err := root.PersistentFlags().Set("profile", "prod"); err != nil {
+		t.Fatal(err)
+	}
+	ms, err = NewSDKClient(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ms.APIKey(); got != "token-prod" {
+		t.Errorf("with --profile prod got token %q, want token-prod", got)
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"-7d", now.AddDate(0, 0, -7)},
+		{"7d", now.AddDate(0, 0, -7)},
+		{"2w", now.AddDate(0, 0, -14)},
+		{"-24h", now.Add(-24 * time.Hour)},
+		{"2025-01-01", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseSince(tt.value, now)
+		if err != nil {
+			t.Errorf("ParseSince(%q): %v", tt.value, err)
+			continue
+		}
+		if got != tt.want.Unix() {
+			t.Errorf("ParseSince(%q) = %d, want %d", tt.value, got, tt.want.Unix())
+		}
+	}
+
+	if _, err := ParseSince("-7x", now); err == nil {
+		t.Error("expected error for unknown unit")
+	}
+}
+
+func TestDomainFlag_FallsBackToProfileDefault(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "")
+	cfg := &config.Config{
+		ActiveProfile: "work",
+		Profiles: map[string]config.Profile{
+			"work":    {APIToken: "a", DefaultDomain: "dom-work"},
+			"staging": {APIToken: "b", DefaultDomain: "dom-staging"},
+		},
+	}
+	if err := config.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	newCmd := func(args ...string) *cobra.Command {
+		root := &cobra.Command{Use: "mailersend"}
+		root.PersistentFlags().String("profile", "", "")
+		sub := &cobra.Command{Use: "sub", RunE: func(*cobra.Command, []string) error { return nil }}
+		sub.Flags().String("domain", "", "")
+		root.AddCommand(sub)
+		root.SetArgs(append([]string{"sub"}, args...))
+		if err := root.Execute(); err != nil {
+			t.Fatal(err)
+		}
+		return sub
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, "dom-work"},
+		{[]string{"--profile", "staging"}, "dom-staging"},
+		{[]string{"--domain", "example.com"}, "example.com"},
+	}
+	for _, tt := range tests {
+		if got := DomainFlag(newCmd(tt.args...)); got != tt.want {
+			t.Errorf("DomainFlag(%v) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+
+	// A token from the environment is not the profile's, so neither is its
+	// default domain.
+	t.Setenv("MAILERSEND_API_TOKEN", "env-token")
+	if got := DomainFlag(newCmd()); got != "" {
+		t.Errorf("DomainFlag with MAILERSEND_API_TOKEN = %q, want empty", got)
+	}
+}
+
+func TestCompleteDomainNames(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("MAILERSEND_API_TOKEN", "test-token")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(domainListResponse([]map[string]string{ //nolint:errcheck
+			{"id": "d1", "name": "example.com"},
+			{"id": "d2", "name": "mail.example.org"},
+			{"id": "d3", "name": "example.net"},
+		}))
+	}))
+	defer srv.Close()
+	t.Setenv("MAILERSEND_API_BASE_URL", srv.URL)
+
+	root := &cobra.Command{Use: "mailersend"}
+	root.PersistentFlags().String("profile", "", "")
+	root.PersistentFlags().Bool("verbose", false, "")
+	sub := &cobra.Command{Use: "list"}
+	sub.Flags().String("domain", "", "")
+	root.AddCommand(sub)
+	RegisterDomainCompletion(root)
+
+	fn, ok := sub.GetFlagCompletionFunc("domain")
+	if !ok {
+		t.Fatal("expected --domain completion to be registered")
+	}
+	got, directive := fn(sub, nil, "example.")
+	if strings.Join(got, ",") != "example.com,example.net" {
+		t.Errorf("completions = %v, want [example.com example.net]", got)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want NoFileComp", directive)
+	}
+}
+
+func TestCompleteSlice_SkipsGivenValues(t *testing.T) {
+	got, _ := CompleteSlice([]string{"valid", "typo", "unknown"})(nil, nil, "typo,")
+	if strings.Join(got, " ") != "typo,valid typo,unknown" {
+		t.Errorf("completions = %v", got)
+	}
+}
@@ -0,0 +1,61 @@
+package cmdutil
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// CompleteDomainNames completes a --domain flag with the names of the
+// account's domains. It lists them through the API, so it needs a working
+// token; on any error it offers no completions rather than failing.
+func CompleteDomainNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ms, err := NewSDKClient(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	domains, err := listAllDomains(ms)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, d := range domains {
+		if strings.HasPrefix(d.Name, toComplete) {
+			names = append(names, d.Name)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// RegisterDomainCompletion registers CompleteDomainNames for the --domain
+// flag of root and every command below it that defines one.
+func RegisterDomainCompletion(root *cobra.Command) {
+	if root.Flags().Lookup("domain") != nil {
+		_ = root.RegisterFlagCompletionFunc("domain", CompleteDomainNames)
+	}
+	for _, c := range root.Commands() {
+		RegisterDomainCompletion(c)
+	}
+}
+
+// CompleteSlice completes a comma-separated slice flag with choices,
+// offering only the values not already given before the last comma.
+func CompleteSlice(choices []string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		done, prefix := "", toComplete
+		if i := strings.LastIndex(toComplete, ","); i >= 0 {
+			done, prefix = toComplete[:i+1], toComplete[i+1:]
+		}
+		given := map[string]bool{}
+		for _, v := range strings.Split(done, ",") {
+			given[v] = true
+		}
+		var out []string
+		for _, c := range choices {
+			if !given[c] && strings.HasPrefix(c, prefix) {
+				out = append(out, done+c)
+			}
+		}
+		return out, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
@@ -0,0 +1,224 @@
+package cmdutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-go"
+)
+
+// domainCacheTTL is how long a resolved domain name→ID mapping is trusted.
+const domainCacheTTL = 5 * time.Minute
+
+// domainCacheEntry is one cached domain, stored under "<scope>|<name>".
+type domainCacheEntry struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+var (
+	domainCacheMu sync.Mutex
+	// clientScopes maps SDK clients created by NewSDKClient to the cache
+	// scope (profile) they authenticate as. Clients not registered here
+	// bypass the cache entirely.
+	clientScopes = map[*mailersend.Mailersend]string{}
+	// nowFunc is replaced in tests to simulate TTL expiry.
+	nowFunc = time.Now
+)
+
+func domainCachePath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "domain-cache.json"), nil
+}
+
+// cacheScope returns the cache scope for a profile. When the token comes
+// from a token file or MAILERSEND_API_TOKEN the scope is derived from the
+// token itself so that different accounts never share entries. A config
+// file other than the default and a custom API base URL are part of the
+// scope as well.
+func cacheScope(profile string) string {
+	scope := profile
+	if token, _ := config.EnvToken(); token != "" {
+		sum := sha256.Sum256([]byte(token))
+		scope = "env-" + hex.EncodeToString(sum[:6])
+	} else {
+		if scope == "" {
+			scope = "default"
+			if cfg, err := config.Load(); err == nil {
+				if name, _, err := config.ActiveProfile(cfg); err == nil {
+					scope = name
+				}
+			}
+		}
+		if path := config.PathOverride(); path != "" {
+			scope += ":" + path
+		}
+	}
+	if base := baseURL(); base != "" {
+		scope += "@" + base
+	}
+	return scope
+}
+
+func registerClientScope(ms *mailersend.Mailersend, scope string) {
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	clientScopes[ms] = scope
+}
+
+func lookupClientScope(ms *mailersend.Mailersend) (string, bool) {
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	scope, ok := clientScopes[ms]
+	return scope, ok
+}
+
+// loadDomainCache reads the cache file. A missing or corrupt file is treated
+// as an empty cache. Callers must hold domainCacheMu.
+func loadDomainCache() map[string]domainCacheEntry {
+	entries := map[string]domainCacheEntry{}
+	p, err := domainCachePath()
+	if err != nil {
+		return entries
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return entries
+	}
+	_ = json.Unmarshal(data, &entries)
+	return entries
+}
+
+// saveDomainCache writes the cache file atomically via a temp file and
+// rename, so concurrent CLI invocations never observe a partial write.
+// Callers must hold domainCacheMu.
+func saveDomainCache(entries map[string]domainCacheEntry) {
+	p, err := domainCachePath()
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), "domain-cache-*.json")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+func domainCacheKey(scope, name string) string {
+	return scope + "|" + strings.ToLower(name)
+}
+
+// cachedDomainID returns the cached ID for a domain name, if fresh.
+func cachedDomainID(ms *mailersend.Mailersend, name string) (string, bool) {
+	scope, ok := lookupClientScope(ms)
+	if !ok {
+		return "", false
+	}
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	e, ok := loadDomainCache()[domainCacheKey(scope, name)]
+	if !ok || !nowFunc().Before(e.ExpiresAt) {
+		return "", false
+	}
+	return e.ID, true
+}
+
+// cachedDomainName returns the cached name for a domain ID, if fresh.
+func cachedDomainName(ms *mailersend.Mailersend, id string) (string, bool) {
+	scope, ok := lookupClientScope(ms)
+	if !ok {
+		return "", false
+	}
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	prefix := scope + "|"
+	for k, e := range loadDomainCache() {
+		if strings.HasPrefix(k, prefix) && e.ID == id && nowFunc().Before(e.ExpiresAt) {
+			return e.Name, true
+		}
+	}
+	return "", false
+}
+
+// storeDomains records every listed domain in the cache and drops expired
+// entries.
+func storeDomains(ms *mailersend.Mailersend, domains []mailersend.Domain) {
+	scope, ok := lookupClientScope(ms)
+	if !ok {
+		return
+	}
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	entries := loadDomainCache()
+	now := nowFunc()
+	for k, e := range entries {
+		if !now.Before(e.ExpiresAt) {
+			delete(entries, k)
+		}
+	}
+	expires := now.Add(domainCacheTTL)
+	for _, d := range domains {
+		entries[domainCacheKey(scope, d.Name)] = domainCacheEntry{ID: d.ID, Name: d.Name, ExpiresAt: expires}
+	}
+	saveDomainCache(entries)
+}
+
+// InvalidateDomainCache drops all cached domains for the client's profile.
+// Call it after creating or deleting a domain.
+func InvalidateDomainCache(ms *mailersend.Mailersend) {
+	scope, ok := lookupClientScope(ms)
+	if !ok {
+		return
+	}
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	entries := loadDomainCache()
+	prefix := scope + "|"
+	for k := range entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(entries, k)
+		}
+	}
+	saveDomainCache(entries)
+}
+
+// ClearDomainCache deletes the cache file, dropping the entries of every
+// profile. A missing file is not an error.
+func ClearDomainCache() error {
+	p, err := domainCachePath()
+	if err != nil {
+		return err
+	}
+	domainCacheMu.Lock()
+	defer domainCacheMu.Unlock()
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/spf13/cobra"
+)
+
+// AddPageFlags registers the --page and --per-page flags read by FetchList.
+func AddPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("page", 0, "fetch only this page of results and show a page footer")
+	cmd.Flags().Int("per-page", 0, "results per page with --page (10-100, default 25)")
+}
+
+// FetchList fetches a list command's items. When --page or --per-page is
+// given it fetches that single page and sets a footer such as
+// "Page 2 of 17 (showing 25 of 412)" for the table; otherwise it fetches
+// every page up to limit. The pagination metadata is kept for --envelope.
+func FetchList[T any](ctx context.Context, cmd *cobra.Command, fetch sdkclient.PageFetcher[T], limit int) ([]T, error) {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	if !cmd.Flags().Changed("page") && !cmd.Flags().Changed("per-page") {
+		items, meta, err := sdkclient.FetchAllMeta(ctx, fetch, limit)
+		if err != nil {
+			return nil, err
+		}
+		// Every page was fetched, so only the total still applies.
+		if meta.Total > 0 {
+			output.SetPageMeta(map[string]interface{}{"total": meta.Total})
+		}
+		return items, nil
+	}
+
+	if cmd.Flags().Changed("limit") {
+		return nil, fmt.Errorf("--limit cannot be combined with --page or --per-page")
+	}
+	if page == 0 {
+		page = 1
+	}
+	if page < 1 {
+		return nil, fmt.Errorf("--page must be 1 or more")
+	}
+	if perPage == 0 {
+		perPage = 25
+	}
+	if perPage < 10 || perPage > 100 {
+		return nil, fmt.Errorf("--per-page must be between 10 and 100")
+	}
+
+	items, meta, err := sdkclient.FetchPage(ctx, fetch, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+	output.SetPageFooter(meta.Footer(len(items)))
+	output.SetPageMeta(meta.Fields())
+	return items, nil
+}
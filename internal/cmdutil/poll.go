@@ -0,0 +1,62 @@
+package cmdutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// AddWaitFlags registers the --interval and --wait-timeout flags used by
+// commands that poll with --wait. The global --timeout bounds each HTTP
+// request, not the wait as a whole.
+func AddWaitFlags(cmd *cobra.Command, interval, timeout time.Duration) {
+	cmd.Flags().Duration("interval", interval, "time between status checks with --wait")
+	cmd.Flags().Duration("wait-timeout", timeout, "give up waiting after this long with --wait (0 = no limit)")
+}
+
+// WaitFlags returns the --interval and --wait-timeout values registered by
+// AddWaitFlags.
+func WaitFlags(cmd *cobra.Command) (interval, timeout time.Duration) {
+	interval, _ = cmd.Flags().GetDuration("interval")
+	timeout, _ = cmd.Flags().GetDuration("wait-timeout")
+	return interval, timeout
+}
+
+// Poll calls check immediately and then every interval until it reports
+// done or fails. With a positive timeout it gives up once that much time has
+// passed and returns an error.
+func Poll(ctx context.Context, interval, timeout time.Duration, check func(ctx context.Context) (bool, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	if timeout < 0 {
+		return fmt.Errorf("--wait-timeout cannot be negative")
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := check(ctx)
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("timed out after %s waiting for completion", timeout)
+		}
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("timed out after %s waiting for completion", timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
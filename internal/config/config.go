@@ -1,33 +1,149 @@
 package config
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
-const (
-	oauthClientID = "1007"
-	oauthTokenURL = "https://app.mailersend.com/oauth/token"
-)
-
 type Profile struct {
 	APIToken          string `yaml:"api_token,omitempty"`
 	OAuthToken        string `yaml:"oauth_token,omitempty"`
 	OAuthRefreshToken string `yaml:"oauth_refresh_token,omitempty"`
 	OAuthExpiresAt    string `yaml:"oauth_expires_at,omitempty"`
+	// DefaultDomain is the domain ID used by commands that require --domain
+	// when the flag is not given.
+	DefaultDomain string `yaml:"default_domain,omitempty"`
+}
+
+// Theme holds optional hex color overrides shared by CLI output and the TUI.
+type Theme struct {
+	Primary string `yaml:"primary,omitempty"`
+	Success string `yaml:"success,omitempty"`
+	Error   string `yaml:"error,omitempty"`
 }
 
 type Config struct {
 	ActiveProfile string             `yaml:"active_profile"`
 	Profiles      map[string]Profile `yaml:"profiles"`
+	DefaultOutput string             `yaml:"default_output,omitempty"`
+	BaseURL       string             `yaml:"base_url,omitempty"`
+	Theme         Theme              `yaml:"theme,omitempty"`
+}
+
+// Key describes a setting that "config get" and "config set" can access.
+type Key struct {
+	Name        string
+	Description string
+	get         func(*Config) string
+	set         func(*Config, string) error
+}
+
+// Keys lists every config key settable from the command line.
+var Keys = []Key{
+	{
+		Name:        "default_output",
+		Description: "output format when --output is not given: table, json, yaml, or csv",
+		get:         func(c *Config) string { return c.DefaultOutput },
+		set: func(c *Config, v string) error {
+			switch v {
+			case "", "table", "json", "yaml", "csv":
+			default:
+				return fmt.Errorf("invalid default_output %q: use table, json, yaml, or csv", v)
+			}
+			c.DefaultOutput = v
+			return nil
+		},
+	},
+	{
+		Name:        "base_url",
+		Description: "API base URL (MAILERSEND_API_BASE_URL takes precedence)",
+		get:         func(c *Config) string { return c.BaseURL },
+		set: func(c *Config, v string) error {
+			if v != "" {
+				u, err := url.Parse(v)
+				if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return fmt.Errorf("invalid base_url %q: use an http(s) URL", v)
+				}
+			}
+			c.BaseURL = strings.TrimSuffix(v, "/")
+			return nil
+		},
+	},
+	themeKey("primary", func(t *Theme) *string { return &t.Primary }),
+	themeKey("success", func(t *Theme) *string { return &t.Success }),
+	themeKey("error", func(t *Theme) *string { return &t.Error }),
+}
+
+func themeKey(name string, field func(*Theme) *string) Key {
+	return Key{
+		Name:        "theme." + name,
+		Description: name + " color as a hex value, e.g. #1e90ff",
+		get:         func(c *Config) string { return *field(&c.Theme) },
+		set: func(c *Config, v string) error {
+			t := c.Theme
+			*field(&t) = v
+			if err := t.Validate(); err != nil {
+				return err
+			}
+			c.Theme = t
+			return nil
+		},
+	}
+}
+
+func lookupKey(name string) (Key, error) {
+	for _, k := range Keys {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+	names := make([]string, len(Keys))
+	for i, k := range Keys {
+		names[i] = k.Name
+	}
+	return Key{}, fmt.Errorf("unknown config key %q (valid keys: %s)", name, strings.Join(names, ", "))
+}
+
+// GetKey returns the value of a config key, or "" if it is unset.
+func (c *Config) GetKey(name string) (string, error) {
+	k, err := lookupKey(name)
+	if err != nil {
+		return "", err
+	}
+	return k.get(c), nil
+}
+
+// SetKey validates and sets a config key. An empty value unsets it.
+func (c *Config) SetKey(name, value string) error {
+	k, err := lookupKey(name)
+	if err != nil {
+		return err
+	}
+	return k.set(c, value)
+}
+
+var hexColorRe = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Validate checks that every set theme color is a #RGB or #RRGGBB hex value.
+func (t Theme) Validate() error {
+	for _, c := range []struct{ key, value string }{
+		{"primary", t.Primary},
+		{"success", t.Success},
+		{"error", t.Error},
+	} {
+		if c.value != "" && !hexColorRe.MatchString(c.value) {
+			return fmt.Errorf("invalid theme.%s color %q: use a hex value like #1e90ff", c.key, c.value)
+		}
+	}
+	return nil
 }
 
 func Dir() (string, error) {
@@ -41,7 +157,34 @@ func Dir() (string, error) {
 	return filepath.Join(home, ".config", "mailersend"), nil
 }
 
+// ConfigEnvVar names the environment variable that overrides the config
+// file path when --config is not given.
+const ConfigEnvVar = "MAILERSEND_CONFIG"
+
+// pathOverride is the --config flag value.
+var pathOverride string
+
+// SetPath sets the config file given with --config. It takes precedence over
+// ConfigEnvVar.
+func SetPath(path string) {
+	pathOverride = path
+}
+
+// PathOverride returns the config file given with --config or
+// $MAILERSEND_CONFIG, or "" when the default path is used.
+func PathOverride() string {
+	if pathOverride != "" {
+		return pathOverride
+	}
+	return os.Getenv(ConfigEnvVar)
+}
+
+// Path returns the config file path: --config, then $MAILERSEND_CONFIG,
+// then config.yaml in Dir. Load, Save, and GetToken all read it.
 func Path() (string, error) {
+	if p := PathOverride(); p != "" {
+		return p, nil
+	}
 	dir, err := Dir()
 	if err != nil {
 		return "", err
@@ -110,10 +253,162 @@ func ActiveProfile(cfg *Config) (string, Profile, error) {
 	return name, p, nil
 }
 
+// RenameProfile moves profile oldName to newName, keeping it active if it
+// was. It fails if oldName does not exist or newName is taken.
+func (c *Config) RenameProfile(oldName, newName string) error {
+	p, ok := c.Profiles[oldName]
+	if !ok {
+		return fmt.Errorf("profile %q not found", oldName)
+	}
+	if newName == "" {
+		return fmt.Errorf("new profile name cannot be empty")
+	}
+	if _, exists := c.Profiles[newName]; exists {
+		return fmt.Errorf("profile %q already exists", newName)
+	}
+	delete(c.Profiles, oldName)
+	c.Profiles[newName] = p
+	if c.ActiveProfile == oldName {
+		c.ActiveProfile = newName
+	}
+	return nil
+}
+
+// RemoveProfile deletes the named profile. If it was active, the first
+// remaining profile by name becomes active, or none if it was the last.
+func (c *Config) RemoveProfile(name string) error {
+	if _, ok := c.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	delete(c.Profiles, name)
+	if c.ActiveProfile == name {
+		c.ActiveProfile = ""
+		names := make([]string, 0, len(c.Profiles))
+		for n := range c.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		if len(names) > 0 {
+			c.ActiveProfile = names[0]
+		}
+	}
+	return nil
+}
+
+// Method returns how the profile authenticates: "token" or "oauth".
+func (p Profile) Method() string {
+	if p.APIToken == "" && p.OAuthToken != "" {
+		return "oauth"
+	}
+	return "token"
+}
+
+// Token returns the credential GetToken uses for p: the API token, or the
+// OAuth access token when there is none.
+func (p Profile) Token() string {
+	if p.Method() == "oauth" {
+		return p.OAuthToken
+	}
+	return p.APIToken
+}
+
+// MaskToken shortens a token for display, keeping only enough of it to tell
+// tokens apart, e.g. "mlsn_ab...wxyz". Short tokens are fully masked and an
+// empty token stays empty.
+func MaskToken(t string) string {
+	switch {
+	case t == "":
+		return ""
+	case len(t) > 10:
+		return t[:7] + "..." + t[len(t)-4:]
+	default:
+		return "***"
+	}
+}
+
+// ProfileEnvVar names the environment variable that selects a profile when
+// --profile is not given.
+const ProfileEnvVar = "MAILERSEND_PROFILE"
+
+// TokenFileEnvVar names the environment variable pointing at a file that
+// holds the API token, as CI systems that mount secrets as files provide.
+const TokenFileEnvVar = "MAILERSEND_API_TOKEN_FILE"
+
+// tokenFile is the --token-file flag value.
+var tokenFile string
+
+// SetTokenFile sets the file given with --token-file. It takes precedence
+// over TokenFileEnvVar and MAILERSEND_API_TOKEN.
+func SetTokenFile(path string) {
+	tokenFile = path
+}
+
+// EnvToken returns the token given outside the config file: the contents
+// of --token-file or MAILERSEND_API_TOKEN_FILE, or else MAILERSEND_API_TOKEN.
+// It returns "" when none of them is set.
+func EnvToken() (string, error) {
+	path := tokenFile
+	if path == "" {
+		path = os.Getenv(TokenFileEnvVar)
+	}
+	if path != "" {
+		return ReadTokenFile(path)
+	}
+	return os.Getenv("MAILERSEND_API_TOKEN"), nil
+}
+
+// EnvTokenSet reports whether a token is given outside the config file, in
+// which case GetToken does not use the profiles.
+func EnvTokenSet() bool {
+	return tokenFile != "" || os.Getenv(TokenFileEnvVar) != "" || os.Getenv("MAILERSEND_API_TOKEN") != ""
+}
+
+// ReadTokenFile returns the token stored in path, without surrounding
+// whitespace.
+func ReadTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// TokenSource describes where GetToken takes its token from: --token-file,
+// TokenFileEnvVar, MAILERSEND_API_TOKEN, or a named profile.
+func TokenSource(profileOverride string) string {
+	switch {
+	case tokenFile != "":
+		return "--token-file " + tokenFile
+	case os.Getenv(TokenFileEnvVar) != "":
+		return TokenFileEnvVar
+	case os.Getenv("MAILERSEND_API_TOKEN") != "":
+		return "MAILERSEND_API_TOKEN"
+	}
+	if profileOverride == "" {
+		profileOverride = os.Getenv(ProfileEnvVar)
+	}
+	if profileOverride == "" {
+		if cfg, err := Load(); err == nil {
+			profileOverride, _, _ = ActiveProfile(cfg)
+		}
+	}
+	return fmt.Sprintf("profile %q", profileOverride)
+}
+
+// GetToken returns the API token to use. A token file or
+// MAILERSEND_API_TOKEN (see EnvToken) takes precedence over the profile
+// selected by profileOverride, $MAILERSEND_PROFILE, or the active profile.
 func GetToken(profileOverride string) (string, error) {
-	// Environment variable takes highest precedence
-	if token := os.Getenv("MAILERSEND_API_TOKEN"); token != "" {
-		return token, nil
+	token, err := EnvToken()
+	if err != nil || token != "" {
+		return token, err
+	}
+	if profileOverride == "" {
+		profileOverride = os.Getenv(ProfileEnvVar)
 	}
 
 	cfg, err := Load()
@@ -143,64 +438,57 @@ func GetToken(profileOverride string) (string, error) {
 		return prof.APIToken, nil
 	}
 	if prof.OAuthToken != "" {
-		// Check if token is expired and refresh if needed.
-		if prof.OAuthExpiresAt != "" && prof.OAuthRefreshToken != "" {
-			expiresAt, err := time.Parse(time.RFC3339, prof.OAuthExpiresAt)
-			if err == nil && time.Now().After(expiresAt.Add(-5*time.Minute)) {
-				refreshed, refreshErr := refreshOAuthToken(prof.OAuthRefreshToken)
-				if refreshErr == nil {
-					cfg.Profiles[profName] = refreshed
-					_ = Save(cfg)
-					return refreshed.OAuthToken, nil
-				}
-				// If refresh fails but token isn't actually expired yet, use it anyway.
-				if time.Now().Before(expiresAt) {
-					return prof.OAuthToken, nil
-				}
-				return "", fmt.Errorf("OAuth token expired and refresh failed: %w", refreshErr)
-			}
-		}
-		return prof.OAuthToken, nil
+		return oauthToken(cfg, profName, prof)
 	}
 	return "", fmt.Errorf("no token found — run 'mailersend auth login' or set MAILERSEND_API_TOKEN")
 }
 
-// refreshOAuthToken exchanges a refresh token for a new access token.
-func refreshOAuthToken(refreshToken string) (Profile, error) {
-	data := url.Values{
-		"grant_type":    {"refresh_token"},
-		"client_id":     {oauthClientID},
-		"refresh_token": {refreshToken},
-	}
+// refreshWindow is how long before expiry an OAuth access token is
+// refreshed, so that it does not lapse mid-command.
+const refreshWindow = time.Minute
 
-	resp, err := http.Post(oauthTokenURL, "application/x-www-form-urlencoded", strings.NewReader(data.Encode())) //nolint:gosec,noctx
-	if err != nil {
-		return Profile{}, fmt.Errorf("refresh request failed: %w", err)
-	}
-	defer resp.Body.Close() //nolint:errcheck
+var tokenRefresher func(Profile) (Profile, error)
 
-	if resp.StatusCode != http.StatusOK {
-		return Profile{}, fmt.Errorf("refresh failed (HTTP %d)", resp.StatusCode)
-	}
+// SetTokenRefresher registers the function GetToken uses to exchange an OAuth
+// profile's refresh token for new tokens. The auth command registers it; the
+// OAuth endpoint details live there.
+func SetTokenRefresher(fn func(Profile) (Profile, error)) {
+	tokenRefresher = fn
+}
 
-	var tok struct {
-		AccessToken  string `json:"access_token"`
-		RefreshToken string `json:"refresh_token"`
-		ExpiresIn    int64  `json:"expires_in"`
+// oauthToken returns the profile's OAuth access token, refreshing and saving
+// it first when it expires within refreshWindow.
+func oauthToken(cfg *Config, profName string, prof Profile) (string, error) {
+	if prof.OAuthExpiresAt == "" {
+		return prof.OAuthToken, nil
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
-		return Profile{}, fmt.Errorf("failed to parse refresh response: %w", err)
+	expiresAt, err := time.Parse(time.RFC3339, prof.OAuthExpiresAt)
+	if err != nil || time.Now().Before(expiresAt.Add(-refreshWindow)) {
+		return prof.OAuthToken, nil
 	}
 
-	if tok.AccessToken == "" {
-		return Profile{}, fmt.Errorf("server returned empty access token on refresh")
+	if prof.OAuthRefreshToken == "" || tokenRefresher == nil {
+		if time.Now().Before(expiresAt) {
+			return prof.OAuthToken, nil
+		}
+		return "", fmt.Errorf("OAuth token for profile %q has expired — run 'mailersend auth login' to sign in again", profName)
 	}
 
-	expiresAt := time.Now().Add(time.Duration(tok.ExpiresIn) * time.Second).Format(time.RFC3339)
+	refreshed, err := tokenRefresher(prof)
+	if err != nil {
+		// A token that has not quite expired is still usable.
+		if time.Now().Before(expiresAt) {
+			return prof.OAuthToken, nil
+		}
+		return "", fmt.Errorf("OAuth token for profile %q expired and could not be refreshed: %w — run 'mailersend auth login' to sign in again", profName, err)
+	}
 
-	return Profile{
-		OAuthToken:        tok.AccessToken,
-		OAuthRefreshToken: tok.RefreshToken,
-		OAuthExpiresAt:    expiresAt,
-	}, nil
+	prof.OAuthToken = refreshed.OAuthToken
+	prof.OAuthRefreshToken = refreshed.OAuthRefreshToken
+	prof.OAuthExpiresAt = refreshed.OAuthExpiresAt
+	cfg.Profiles[profName] = prof
+	if err := Save(cfg); err != nil {
+		return "", fmt.Errorf("failed to save refreshed OAuth token: %w", err)
+	}
+	return refreshed.OAuthToken, nil
 }
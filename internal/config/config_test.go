@@ -1,10 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 // setTempConfigDir points XDG_CONFIG_HOME at a temp directory so that
@@ -13,6 +15,7 @@ func setTempConfigDir(t *testing.T) string {
 	t.Helper()
 	tmp := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tmp)
+	t.Setenv(ConfigEnvVar, "")
 	return tmp
 }
 
@@ -432,6 +435,72 @@ profiles:
 	}
 }
 
+func TestGetToken_RefreshesExpiringOAuthToken(t *testing.T) {
+	setTempConfigDir(t)
+	t.Setenv("MAILERSEND_API_TOKEN", "")
+
+	expiresAt := time.Now().Add(30 * time.Second).Format(time.RFC3339)
+	writeConfigFile(t, `
+active_profile: default
+profiles:
+  default:
+    oauth_token: "old_access"
+    oauth_refresh_token: "refresh"
+    oauth_expires_at: "`+expiresAt+`"
+`)
+
+	defer SetTokenRefresher(nil)
+	SetTokenRefresher(func(p Profile) (Profile, error) {
+		if p.OAuthRefreshToken != "refresh" {
+			t.Errorf("refresh token = %q, want %q", p.OAuthRefreshToken, "refresh")
+		}
+		return Profile{OAuthToken: "new_access", OAuthRefreshToken: "refresh2", OAuthExpiresAt: "2099-01-01T00:00:00Z"}, nil
+	})
+
+	token, err := GetToken("")
+	if err != nil {
+		t.Fatalf("GetToken() error: %v", err)
+	}
+	if token != "new_access" {
+		t.Errorf("token = %q, want %q", token, "new_access")
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := cfg.Profiles["default"]; p.OAuthToken != "new_access" || p.OAuthRefreshToken != "refresh2" {
+		t.Errorf("saved profile = %+v, want refreshed tokens", p)
+	}
+}
+
+func TestGetToken_ExpiredOAuthTokenRefreshFails(t *testing.T) {
+	setTempConfigDir(t)
+	t.Setenv("MAILERSEND_API_TOKEN", "")
+
+	writeConfigFile(t, `
+active_profile: default
+profiles:
+  default:
+    oauth_token: "old_access"
+    oauth_refresh_token: "refresh"
+    oauth_expires_at: "2020-01-01T00:00:00Z"
+`)
+
+	defer SetTokenRefresher(nil)
+	SetTokenRefresher(func(Profile) (Profile, error) {
+		return Profile{}, fmt.Errorf("invalid_grant")
+	})
+
+	_, err := GetToken("")
+	if err == nil {
+		t.Fatal("expected an error when the refresh fails")
+	}
+	if !strings.Contains(err.Error(), "auth login") {
+		t.Errorf("error = %q, want a hint to run auth login", err)
+	}
+}
+
 func TestGetToken_NoTokenAtAll(t *testing.T) {
 	setTempConfigDir(t)
 	t.Setenv("MAILERSEND_API_TOKEN", "")
@@ -464,3 +533,193 @@ func TestGetToken_NoProfilesNoEnv(t *testing.T) {
 		t.Errorf("error = %q, want it to contain 'no profiles configured'", err.Error())
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Theme
+// ---------------------------------------------------------------------------
+
+func TestLoad_ThemeSection(t *testing.T) {
+	setTempConfigDir(t)
+	writeConfigFile(t, `
+theme:
+  primary: "#1e90ff"
+  success: "#0f0"
+`)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.Theme.Primary != "#1e90ff" {
+		t.Errorf("Theme.Primary = %q, want %q", cfg.Theme.Primary, "#1e90ff")
+	}
+	if cfg.Theme.Success != "#0f0" {
+		t.Errorf("Theme.Success = %q, want %q", cfg.Theme.Success, "#0f0")
+	}
+	if err := cfg.Theme.Validate(); err != nil {
+		t.Errorf("Validate() error: %v", err)
+	}
+}
+
+func TestThemeValidate_InvalidHex(t *testing.T) {
+	for _, bad := range []string{"red", "#12345", "1e90ff", "#ggg"} {
+		err := Theme{Error: bad}.Validate()
+		if err == nil {
+			t.Errorf("expected error for %q, got nil", bad)
+			continue
+		}
+		if !strings.Contains(err.Error(), "theme.error") {
+			t.Errorf("error = %q, want it to name theme.error", err.Error())
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// GetKey / SetKey
+// ---------------------------------------------------------------------------
+
+func TestSetKey_ValidatesValues(t *testing.T) {
+	cfg := &Config{}
+	tests := []struct {
+		key, value string
+		wantErr    bool
+	}{
+		{"default_output", "json", false},
+		{"default_output", "xml", true},
+		{"default_output", "csv", false},
+		{"base_url", "https://api.example.com/v1/", false},
+		{"base_url", "api.example.com", true},
+		{"theme.primary", "#1e90ff", false},
+		{"theme.error", "red", true},
+		{"unknown", "x", true},
+	}
+	for _, tt := range tests {
+		err := cfg.SetKey(tt.key, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SetKey(%q, %q) error = %v, wantErr %v", tt.key, tt.value, err, tt.wantErr)
+		}
+	}
+	if cfg.BaseURL != "https://api.example.com/v1" {
+		t.Errorf("BaseURL = %q, want trailing slash trimmed", cfg.BaseURL)
+	}
+	if cfg.Theme.Error != "" {
+		t.Errorf("Theme.Error = %q, want invalid value rejected", cfg.Theme.Error)
+	}
+	if got, _ := cfg.GetKey("theme.primary"); got != "#1e90ff" {
+		t.Errorf("GetKey(theme.primary) = %q, want %q", got, "#1e90ff")
+	}
+}
+
+func TestRenameProfile(t *testing.T) {
+	cfg := &Config{
+		ActiveProfile: "old",
+		Profiles:      map[string]Profile{"old": {APIToken: "a"}, "other": {APIToken: "b"}},
+	}
+	if err := cfg.RenameProfile("missing", "x"); err == nil {
+		t.Error("expected an error renaming a missing profile")
+	}
+	if err := cfg.RenameProfile("old", "other"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+	if err := cfg.RenameProfile("old", "new"); err != nil {
+		t.Fatalf("RenameProfile() error: %v", err)
+	}
+	if _, ok := cfg.Profiles["old"]; ok {
+		t.Error("old profile still present")
+	}
+	if cfg.Profiles["new"].APIToken != "a" {
+		t.Errorf("new profile = %+v, want the old profile's token", cfg.Profiles["new"])
+	}
+	if cfg.ActiveProfile != "new" {
+		t.Errorf("ActiveProfile = %q, want %q", cfg.ActiveProfile, "new")
+	}
+}
+
+func TestRemoveProfile_RepointsActive(t *testing.T) {
+	cfg := &Config{
+		ActiveProfile: "b",
+		Profiles:      map[string]Profile{"a": {}, "b": {}, "c": {}},
+	}
+	if err := cfg.RemoveProfile("missing"); err == nil {
+		t.Error("expected an error removing a missing profile")
+	}
+	if err := cfg.RemoveProfile("b"); err != nil {
+		t.Fatalf("RemoveProfile() error: %v", err)
+	}
+	if cfg.ActiveProfile != "a" {
+		t.Errorf("ActiveProfile = %q, want %q", cfg.ActiveProfile, "a")
+	}
+	if err := cfg.RemoveProfile("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.RemoveProfile("c"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ActiveProfile != "" {
+		t.Errorf("ActiveProfile = %q, want empty after removing the last profile", cfg.ActiveProfile)
+	}
+}
+
+func TestGetToken_TokenFilePrecedence(t *testing.T) {
+	setTempConfigDir(t)
+	writeConfigFile(t, `
+active_profile: default
+profiles:
+  default:
+    api_token: "config_token"
+`)
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "env-token")
+	flagFile := filepath.Join(dir, "flag-token")
+	if err := os.WriteFile(envFile, []byte("file_env_token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(flagFile, []byte("  file_flag_token \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("MAILERSEND_API_TOKEN", "env_token")
+	t.Setenv(TokenFileEnvVar, envFile)
+	defer SetTokenFile("")
+
+	if token, err := GetToken(""); err != nil || token != "file_env_token" {
+		t.Errorf("with MAILERSEND_API_TOKEN_FILE: GetToken() = %q, %v; want file_env_token", token, err)
+	}
+
+	SetTokenFile(flagFile)
+	if token, err := GetToken(""); err != nil || token != "file_flag_token" {
+		t.Errorf("with --token-file: GetToken() = %q, %v; want file_flag_token", token, err)
+	}
+
+	SetTokenFile(filepath.Join(dir, "missing"))
+	if _, err := GetToken(""); err == nil || !strings.Contains(err.Error(), "token file") {
+		t.Errorf("missing token file: err = %v, want a token file error", err)
+	}
+}
+
+func TestPath_Overrides(t *testing.T) {
+	tmp := setTempConfigDir(t)
+	t.Setenv("MAILERSEND_API_TOKEN", "")
+	defer SetPath("")
+
+	envPath := filepath.Join(tmp, "project", "env.yaml")
+	t.Setenv(ConfigEnvVar, envPath)
+	if p, _ := Path(); p != envPath {
+		t.Errorf("with MAILERSEND_CONFIG: Path() = %q, want %q", p, envPath)
+	}
+
+	flagPath := filepath.Join(tmp, "project", "flag.yaml")
+	SetPath(flagPath)
+	if p, _ := Path(); p != flagPath {
+		t.Errorf("with --config: Path() = %q, want %q", p, flagPath)
+	}
+
+	if err := Save(&Config{ActiveProfile: "ci", Profiles: map[string]Profile{"ci": {APIToken: "flag_token"}}}); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if _, err := os.Stat(flagPath); err != nil {
+		t.Fatalf("Save() did not write --config: %v", err)
+	}
+	if token, err := GetToken(""); err != nil || token != "flag_token" {
+		t.Errorf("GetToken() = %q, %v; want flag_token", token, err)
+	}
+}
@@ -0,0 +1,63 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+)
+
+// ansiPattern matches terminal escape sequences: CSI sequences such as
+// colors and cursor movement, OSC sequences such as hyperlinks and window
+// titles (terminated by BEL or ST), and the remaining two-byte escapes.
+var ansiPattern = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[@-Z\\-_])`)
+
+// StripANSI returns s with all terminal escape sequences removed.
+func StripANSI(s string) string {
+	return ansiPattern.ReplaceAllString(s, "")
+}
+
+// StartStripColor redirects os.Stdout through a filter that removes escape
+// sequences from everything written to it, whatever the color mode. The
+// returned stop function restores os.Stdout and flushes the filter; it must
+// be called once all output has been written.
+func StartStripColor() (func(), error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output pipe: %w", err)
+	}
+
+	orig := os.Stdout
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer r.Close() //nolint:errcheck
+		copyStripped(orig, r)
+	}()
+
+	os.Stdout = w
+	return func() {
+		os.Stdout = orig
+		w.Close() //nolint:errcheck
+		<-done
+	}, nil
+}
+
+// copyStripped copies src to dst line by line with escape sequences
+// removed. Sequences never span a newline, so stripping per line is safe.
+func copyStripped(dst io.Writer, src io.Reader) {
+	br := bufio.NewReader(src)
+	for {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			if _, werr := io.WriteString(dst, StripANSI(line)); werr != nil {
+				_, _ = io.Copy(io.Discard, br)
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text", "hello", "hello"},
+		{"sgr color", "\x1b[31mred\x1b[0m", "red"},
+		{"sgr with params", "\x1b[1;38;2;127;86;217mbold\x1b[m text", "bold text"},
+		{"cursor movement", "a\x1b[2Kb\x1b[1Ac", "abc"},
+		{"private mode", "\x1b[?25lhidden\x1b[?25h", "hidden"},
+		{"osc hyperlink bel", "\x1b]8;;https://example.com\x07link\x1b]8;;\x07", "link"},
+		{"osc title st", "\x1b]0;title\x1b\\body", "body"},
+		{"two byte escape", "\x1bMup", "up"},
+		{"keeps newlines and tabs", "\x1b[32mok\x1b[0m\tdone\n", "ok\tdone\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripANSI(tt.in); got != tt.want {
+				t.Errorf("StripANSI(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyStripped(t *testing.T) {
+	var buf bytes.Buffer
+	copyStripped(&buf, strings.NewReader("\x1b[1mID\x1b[0m\n\x1b[36mabc\x1b[0m\nno newline \x1b[2mend\x1b[0m"))
+	if got, want := buf.String(), "ID\nabc\nno newline end"; got != want {
+		t.Errorf("copyStripped() = %q, want %q", got, want)
+	}
+}
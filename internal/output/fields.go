@@ -0,0 +1,197 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// renderErr holds an error from a renderer without an error return, such as
+// Table. Err reports it once the command has finished.
+var renderErr error
+
+// Err returns and clears the last error recorded while rendering output.
+func Err() error {
+	err := renderErr
+	renderErr = nil
+	return err
+}
+
+// SetFields limits tables to the named columns and JSON objects to the named
+// keys, in the given order. Names match case-insensitively, with "_" or "-"
+// standing in for spaces, so "created_at" selects the "CREATED AT" column
+// and the created_at key. In FIELD/VALUE views they select rows by field
+// name. An empty list shows everything.
+func SetFields(names []string) {
+	fields = names
+}
+
+func normalizeField(s string) string {
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// ProjectColumns matches names against headers and returns the selected
+// headers along with, for each, the index of the source column. An unknown
+// name is an error that lists the valid ones.
+func ProjectColumns(headers, names []string) ([]string, []int, error) {
+	index := make(map[string]int, len(headers))
+	for i, h := range headers {
+		index[normalizeField(h)] = i
+	}
+
+	projected := make([]string, 0, len(names))
+	cols := make([]int, 0, len(names))
+	for _, name := range names {
+		i, ok := index[normalizeField(name)]
+		if !ok {
+			valid := make([]string, len(headers))
+			for j, h := range headers {
+				valid[j] = strings.ToLower(normalizeField(h))
+			}
+			return nil, nil, fmt.Errorf("unknown field %q (valid fields: %s)", name, strings.Join(valid, ", "))
+		}
+		projected = append(projected, headers[i])
+		cols = append(cols, i)
+	}
+	return projected, cols, nil
+}
+
+// selectColumns applies SetFields to a table.
+func selectColumns(headers []string, rows [][]string) ([]string, [][]string, error) {
+	if len(fields) == 0 {
+		return headers, rows, nil
+	}
+	selectedHeaders, cols, err := ProjectColumns(headers, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	selectedRows := make([][]string, len(rows))
+	for r, row := range rows {
+		selected := make([]string, len(cols))
+		for j, i := range cols {
+			if i < len(row) {
+				selected[j] = row[i]
+			}
+		}
+		selectedRows[r] = selected
+	}
+	return selectedHeaders, selectedRows, nil
+}
+
+// isFieldView reports whether headers are those of a FIELD/VALUE view.
+func isFieldView(headers []string) bool {
+	return len(headers) == 2 && normalizeField(headers[0]) == "field" && normalizeField(headers[1]) == "value"
+}
+
+// selectFieldRows applies SetFields to the rows of a FIELD/VALUE view.
+func selectFieldRows(rows [][]string) ([][]string, error) {
+	index := make(map[string]int, len(rows))
+	for i, row := range rows {
+		index[normalizeField(row[0])] = i
+	}
+	selected := make([][]string, 0, len(fields))
+	for _, name := range fields {
+		i, ok := index[normalizeField(name)]
+		if !ok {
+			valid := make([]string, len(rows))
+			for j, row := range rows {
+				valid[j] = normalizeField(row[0])
+			}
+			return nil, fmt.Errorf("unknown field %q (valid fields: %s)", name, strings.Join(valid, ", "))
+		}
+		selected = append(selected, rows[i])
+	}
+	return selected, nil
+}
+
+// orderedObject is a JSON object that keeps its keys in the order given.
+type orderedObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, _ := json.Marshal(k)
+		val, err := json.Marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(val)
+	}
+	b.WriteByte('}')
+	return []byte(b.String()), nil
+}
+
+// projectJSON applies SetFields to a JSON payload. Arrays are projected
+// element by element, and API roots of the form {"data": ...} are projected
+// inside data so pagination links and other metadata are left alone.
+func projectJSON(v interface{}) (interface{}, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	if obj, ok := generic.(map[string]interface{}); ok {
+		if data, ok := obj["data"]; ok {
+			projected, err := projectValue(data)
+			if err != nil {
+				return nil, err
+			}
+			obj["data"] = projected
+			return obj, nil
+		}
+	}
+	return projectValue(generic)
+}
+
+func projectValue(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, elem := range t {
+			p, err := projectValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = p
+		}
+		return out, nil
+	case map[string]interface{}:
+		index := make(map[string]string, len(t))
+		for k := range t {
+			index[normalizeField(k)] = k
+		}
+		obj := orderedObject{values: make(map[string]interface{}, len(fields))}
+		for _, name := range fields {
+			k, ok := index[normalizeField(name)]
+			if !ok {
+				valid := make([]string, 0, len(t))
+				for k := range t {
+					valid = append(valid, k)
+				}
+				sort.Strings(valid)
+				return nil, fmt.Errorf("unknown field %q (valid fields: %s)", name, strings.Join(valid, ", "))
+			}
+			obj.keys = append(obj.keys, k)
+			obj.values[k] = t[k]
+		}
+		return obj, nil
+	default:
+		return v, nil
+	}
+}
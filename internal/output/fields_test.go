@@ -0,0 +1,80 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProjectColumns(t *testing.T) {
+	headers := []string{"ID", "NAME", "CREATED AT"}
+
+	projected, cols, err := ProjectColumns(headers, []string{"created_at", "Id"})
+	if err != nil {
+		t.Fatalf("ProjectColumns error: %v", err)
+	}
+	if strings.Join(projected, ",") != "CREATED AT,ID" {
+		t.Errorf("headers = %v, want [CREATED AT ID]", projected)
+	}
+	if len(cols) != 2 || cols[0] != 2 || cols[1] != 0 {
+		t.Errorf("cols = %v, want [2 0]", cols)
+	}
+
+	_, _, err = ProjectColumns(headers, []string{"id", "status"})
+	if err == nil {
+		t.Fatal("expected error for unknown field")
+	}
+	if !strings.Contains(err.Error(), `"status"`) || !strings.Contains(err.Error(), "id, name, created_at") {
+		t.Errorf("error = %q, want it to name the field and list valid ones", err)
+	}
+}
+
+func TestTable_UnknownFieldIsError(t *testing.T) {
+	SetFields([]string{"bogus"})
+	defer SetFields(nil)
+
+	out := captureStdout(t, func() {
+		Table([]string{"ID"}, [][]string{{"1"}})
+	})
+	if len(out) != 0 {
+		t.Errorf("expected no table output, got %q", out)
+	}
+	if err := Err(); err == nil || !strings.Contains(err.Error(), "valid fields: id") {
+		t.Errorf("Err() = %v, want unknown field error", err)
+	}
+	if err := Err(); err != nil {
+		t.Errorf("Err() should clear the error, got %v", err)
+	}
+}
+
+func TestJSON_FieldsProjectKeys(t *testing.T) {
+	SetFields([]string{"name", "id"})
+	defer SetFields(nil)
+
+	root := map[string]interface{}{
+		"data": []map[string]interface{}{
+			{"id": "d1", "name": "example.com", "is_verified": true},
+		},
+		"links": map[string]interface{}{"next": nil},
+	}
+	out := captureStdout(t, func() {
+		if err := JSON(root); err != nil {
+			t.Fatalf("JSON() error: %v", err)
+		}
+	})
+
+	got := string(out)
+	if strings.Contains(got, "is_verified") {
+		t.Errorf("expected unselected keys dropped, got:\n%s", got)
+	}
+	if strings.Index(got, `"name"`) > strings.Index(got, `"id"`) {
+		t.Errorf("expected name before id, got:\n%s", got)
+	}
+	if !strings.Contains(got, `"links"`) {
+		t.Errorf("expected metadata outside data kept, got:\n%s", got)
+	}
+
+	SetFields([]string{"nope"})
+	if err := JSON([]map[string]string{{"id": "1"}}); err == nil || !strings.Contains(err.Error(), "valid fields: id") {
+		t.Errorf("JSON() error = %v, want unknown field error", err)
+	}
+}
@@ -0,0 +1,154 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Formats lists the values accepted by --output.
+var Formats = []string{"table", "json", "yaml", "csv"}
+
+var format = "table"
+
+// SetFormat selects the renderer used by Render and JSON: "table" (the
+// default), "json", "yaml", or "csv".
+func SetFormat(value string) error {
+	for _, f := range Formats {
+		if value == f {
+			format = value
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid --output %q: use %s", value, strings.Join(Formats, ", "))
+}
+
+// Render prints tabular data in the selected format. Commands print lists
+// and FIELD/VALUE views through it so that --output applies everywhere.
+//
+// In a FIELD/VALUE view, SetFields picks rows by their FIELD name instead of
+// columns.
+func Render(headers []string, rows [][]string) {
+	if len(fields) > 0 && isFieldView(headers) {
+		selected, err := selectFieldRows(rows)
+		if err != nil {
+			renderErr = err
+			return
+		}
+		rows = selected
+		saved := fields
+		fields = nil
+		defer func() { fields = saved }()
+	}
+
+	switch format {
+	case "csv":
+		if err := CSV(headers, rows); err != nil {
+			renderErr = err
+		}
+	case "yaml", "json":
+		// Commands normally handle structured formats before building a
+		// table; for those that don't, emit the rows as objects. JSON
+		// applies SetFields to them.
+		if err := JSON(rowRecords(headers, rows)); err != nil {
+			renderErr = err
+		}
+	default:
+		Table(headers, rows)
+	}
+}
+
+// CSV writes headers and rows to stdout as RFC 4180 CSV, honoring
+// SetFields and SetIDsOnly like Table does.
+func CSV(headers []string, rows [][]string) error {
+	if idsOnly {
+		printIDs(headers, rows)
+		return nil
+	}
+	headers, rows, err := selectColumns(headers, rows)
+	if err != nil {
+		return err
+	}
+
+	return WriteCSV(os.Stdout, headers, rows)
+}
+
+// WriteCSV writes headers and rows to w as RFC 4180 CSV. Unlike CSV it
+// ignores SetFields and SetIDsOnly, for exports that are not command output.
+func WriteCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// WriteRecords writes rows to w as an indented JSON array with one object
+// per row, keyed by headers in column order.
+func WriteRecords(w io.Writer, headers []string, rows [][]string) error {
+	raw, err := json.MarshalIndent(rowRecords(headers, rows), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(raw, '\n'))
+	return err
+}
+
+// rowRecords converts table rows into objects keyed by headers.
+func rowRecords(headers []string, rows [][]string) []orderedObject {
+	records := make([]orderedObject, len(rows))
+	for i, row := range rows {
+		records[i] = orderedObject{keys: headers, values: make(map[string]interface{}, len(headers))}
+		for j, h := range headers {
+			if j < len(row) {
+				records[i].values[h] = row[j]
+			}
+		}
+	}
+	return records
+}
+
+// YAML writes v to stdout as YAML. v is converted through JSON first so
+// that field names and order follow the same json tags as --json output.
+func YAML(v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// JSON is valid YAML; decoding into a node keeps the key order.
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return err
+	}
+	clearStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+// clearStyle switches a node decoded from JSON from flow style to block
+// style; the encoder quotes scalars only where YAML needs it.
+func clearStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		clearStyle(c)
+	}
+}
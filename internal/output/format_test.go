@@ -0,0 +1,99 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetFormat_Invalid(t *testing.T) {
+	if err := SetFormat("xml"); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+	if format != "table" {
+		t.Errorf("format = %q after invalid SetFormat, want unchanged", format)
+	}
+}
+
+func TestRender_CSV(t *testing.T) {
+	if err := SetFormat("csv"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetFormat("table") //nolint:errcheck
+
+	out := captureStdout(t, func() {
+		Render([]string{"ID", "NAME"}, [][]string{
+			{"1", "example.com"},
+			{"2", `quoted "name", with comma`},
+		})
+	})
+
+	want := "ID,NAME\n1,example.com\n2,\"quoted \"\"name\"\", with comma\"\n"
+	if string(out) != want {
+		t.Errorf("csv output = %q, want %q", out, want)
+	}
+}
+
+func TestJSON_YAMLFormat(t *testing.T) {
+	if err := SetFormat("yaml"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetFormat("table") //nolint:errcheck
+
+	v := struct {
+		Name    string   `json:"name"`
+		ID      string   `json:"id"`
+		Enabled bool     `json:"enabled"`
+		Tags    []string `json:"tags"`
+		Zip     string   `json:"zip"`
+	}{Name: "example.com", ID: "abc", Enabled: true, Tags: []string{"a", "b"}, Zip: "true"}
+
+	out := captureStdout(t, func() {
+		if err := JSON(v); err != nil {
+			t.Fatalf("JSON() error: %v", err)
+		}
+	})
+
+	want := "name: example.com\nid: abc\nenabled: true\ntags:\n  - a\n  - b\nzip: \"true\"\n"
+	if string(out) != want {
+		t.Errorf("yaml output = %q, want %q", out, want)
+	}
+}
+
+func TestFields_CSVWithSelectedFields(t *testing.T) {
+	if err := SetFormat("csv"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetFormat("table") //nolint:errcheck
+	SetFields([]string{"name", "id"})
+	defer SetFields(nil)
+
+	v := map[string]interface{}{"id": "abc", "name": "example.com", "enabled": true}
+	out := captureStdout(t, func() {
+		if err := Fields(v); err != nil {
+			t.Fatalf("Fields() error: %v", err)
+		}
+	})
+	if err := Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+
+	want := "FIELD,VALUE\nname,example.com\nid,abc\n"
+	if string(out) != want {
+		t.Errorf("csv output = %q, want %q", out, want)
+	}
+	if len(fields) != 2 {
+		t.Errorf("fields = %v after Render, want them restored", fields)
+	}
+}
+
+func TestRender_FieldViewUnknownField(t *testing.T) {
+	SetFields([]string{"status"})
+	defer SetFields(nil)
+
+	captureStdout(t, func() {
+		Render([]string{"FIELD", "VALUE"}, [][]string{{"ID", "abc"}, {"Created At", "2024-01-01"}})
+	})
+	if err := Err(); err == nil || !strings.Contains(err.Error(), "valid fields: id, created_at") {
+		t.Errorf("Err() = %v, want an unknown field error listing the rows", err)
+	}
+}
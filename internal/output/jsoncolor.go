@@ -0,0 +1,64 @@
+package output
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	jsonKeyStyle     = lipgloss.NewStyle().Foreground(primaryColor)
+	jsonStringStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	jsonLiteralStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+)
+
+// colorizeJSON styles keys, strings, and numbers, booleans, and null in
+// encoded JSON. Punctuation and whitespace are left as they are, so the
+// layout chosen by --indent is kept.
+func colorizeJSON(data []byte) string {
+	var b strings.Builder
+	s := string(data)
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '"':
+			end := stringEnd(s, i)
+			token := s[i:end]
+			if isJSONKey(s, end) {
+				b.WriteString(jsonKeyStyle.Render(token))
+			} else {
+				b.WriteString(jsonStringStyle.Render(token))
+			}
+			i = end
+		case c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z':
+			end := i
+			for end < len(s) && !strings.ContainsRune(",]} \t\n", rune(s[end])) {
+				end++
+			}
+			b.WriteString(jsonLiteralStyle.Render(s[i:end]))
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// stringEnd returns the index just past the JSON string starting at s[start].
+func stringEnd(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// isJSONKey reports whether the string ending before s[end] is an object key.
+func isJSONKey(s string, end int) bool {
+	rest := strings.TrimLeft(s[end:], " \t\n")
+	return strings.HasPrefix(rest, ":")
+}
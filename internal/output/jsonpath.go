@@ -0,0 +1,139 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// jsonPath holds the parsed --jsonpath expression, if any.
+var jsonPath []pathStep
+
+// pathStep is one segment of a JSONPath expression: a field name, an array
+// index, or the array wildcard.
+type pathStep struct {
+	field    string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// SetJSONPath parses expr and makes JSON print the values it selects, one per
+// line, instead of the whole document. An empty expr turns extraction off.
+//
+// Only a minimal subset of JSONPath is supported: the root "$", field access
+// (".name"), array indexes ("[0]"), and the array wildcard ("[*]").
+func SetJSONPath(expr string) error {
+	if expr == "" {
+		jsonPath = nil
+		return nil
+	}
+	steps, err := parseJSONPath(expr)
+	if err != nil {
+		return err
+	}
+	jsonPath = steps
+	return nil
+}
+
+func parseJSONPath(expr string) ([]pathStep, error) {
+	unsupported := func() error {
+		return fmt.Errorf("unsupported --jsonpath expression %q: use field access and [*], e.g. $[*].id", expr)
+	}
+	if !strings.HasPrefix(expr, "$") {
+		return nil, unsupported()
+	}
+	rest := expr[1:]
+	steps := []pathStep{}
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			if name == "" || name == "*" {
+				return nil, unsupported()
+			}
+			steps = append(steps, pathStep{field: name})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, unsupported()
+			}
+			inner := rest[1:end]
+			if inner == "*" {
+				steps = append(steps, pathStep{wildcard: true})
+			} else {
+				i, err := strconv.Atoi(inner)
+				if err != nil || i < 0 {
+					return nil, unsupported()
+				}
+				steps = append(steps, pathStep{index: i, isIndex: true})
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, unsupported()
+		}
+	}
+	return steps, nil
+}
+
+// extractJSONPath applies steps to a decoded JSON document and returns every
+// matched value. Missing fields and out-of-range indexes match nothing.
+func extractJSONPath(doc interface{}, steps []pathStep) []interface{} {
+	current := []interface{}{doc}
+	for _, step := range steps {
+		var next []interface{}
+		for _, v := range current {
+			switch {
+			case step.wildcard:
+				if arr, ok := v.([]interface{}); ok {
+					next = append(next, arr...)
+				}
+			case step.isIndex:
+				if arr, ok := v.([]interface{}); ok && step.index < len(arr) {
+					next = append(next, arr[step.index])
+				}
+			default:
+				if obj, ok := v.(map[string]interface{}); ok {
+					if field, ok := obj[step.field]; ok {
+						next = append(next, field)
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
+
+// writeJSONPath prints the values jsonPath selects from v, one per line.
+// Strings are printed bare; everything else as compact JSON.
+func writeJSONPath(v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	for _, match := range extractJSONPath(doc, jsonPath) {
+		if s, ok := match.(string); ok {
+			fmt.Fprintln(os.Stdout, s)
+			continue
+		}
+		b, err := json.Marshal(match)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stdout, string(b))
+	}
+	return nil
+}
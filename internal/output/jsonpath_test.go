@@ -0,0 +1,62 @@
+package output
+
+import "testing"
+
+func TestJSONPath_ExtractsIDsFromList(t *testing.T) {
+	if err := SetJSONPath("$.data[*].id"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetJSONPath("") //nolint:errcheck
+
+	resp := map[string]interface{}{
+		"data": []map[string]interface{}{
+			{"id": "d1", "name": "a.com"},
+			{"id": "d2", "name": "b.com"},
+		},
+		"links": map[string]string{"next": ""},
+	}
+	out := captureStdout(t, func() {
+		if err := JSON(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if got := string(out); got != "d1\nd2\n" {
+		t.Errorf("expected one ID per line, got %q", got)
+	}
+}
+
+func TestJSONPath_SingleFieldFromGet(t *testing.T) {
+	if err := SetJSONPath("$.data.domain_settings.send_paused"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetJSONPath("") //nolint:errcheck
+
+	resp := map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":              "d1",
+			"domain_settings": map[string]interface{}{"send_paused": false},
+		},
+	}
+	out := captureStdout(t, func() {
+		if err := JSON(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if got := string(out); got != "false\n" {
+		t.Errorf("expected false, got %q", got)
+	}
+}
+
+func TestJSONPath_UnsupportedExpressions(t *testing.T) {
+	for _, expr := range []string{"data.id", "$..id", "$.data[?(@.id)]", "$.data[-1]", "$.*"} {
+		if err := SetJSONPath(expr); err == nil {
+			t.Errorf("expected error for %q", expr)
+		}
+	}
+	if err := SetJSONPath("$.data[0].id"); err != nil {
+		t.Errorf("expected index access to be supported: %v", err)
+	}
+	SetJSONPath("") //nolint:errcheck
+}
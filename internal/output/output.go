@@ -1,24 +1,89 @@
 package output
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/muesli/termenv"
 )
 
 var (
-	noColor = os.Getenv("NO_COLOR") != ""
+	noColor      = os.Getenv("NO_COLOR") != ""
+	envelope     bool
+	quiet        bool
+	relativeTime bool
+	timeFormat   string
+	jsonIndent   = "  "
+	fields       []string
+	idsOnly      bool
+	idSeparator  = "\n"
 
-	HeaderStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	// summarySingular and summaryPlural name the rows Table prints, for the
+	// count line written to stderr after list output.
+	summarySingular string
+	summaryPlural   string
+	// pageFooter, when set, is written after a table in place of the count.
+	pageFooter string
+	// pageMeta is the pagination metadata Envelope adds to meta.
+	pageMeta map[string]interface{}
+
+	// stdoutIsTerminal is replaced in tests.
+	stdoutIsTerminal = func() bool { return IsTerminal(os.Stdout) }
+
+	primaryColor lipgloss.TerminalColor = lipgloss.Color("12")
+
+	HeaderStyle  = lipgloss.NewStyle().Bold(true).Foreground(primaryColor)
 	SuccessStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
 	ErrorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
 	DimStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
 )
 
+// SetColors overrides the primary, success, and error colors with hex
+// values. Empty arguments keep the defaults.
+func SetColors(primary, success, errColor string) {
+	if primary != "" {
+		primaryColor = lipgloss.Color(primary)
+		HeaderStyle = HeaderStyle.Foreground(primaryColor)
+		jsonKeyStyle = jsonKeyStyle.Foreground(primaryColor)
+	}
+	if success != "" {
+		SuccessStyle = SuccessStyle.Foreground(lipgloss.Color(success))
+	}
+	if errColor != "" {
+		ErrorStyle = ErrorStyle.Foreground(lipgloss.Color(errColor))
+	}
+}
+
+// SetColorMode controls styling: "always", "never", or "auto", which
+// disables color when NO_COLOR is set or stdout is not a terminal. With
+// color off, output is plain text and tables are drawn without borders.
+func SetColorMode(mode string) error {
+	switch mode {
+	case "always":
+		noColor = false
+		lipgloss.SetColorProfile(termenv.ANSI256)
+	case "never":
+		noColor = true
+	case "auto", "":
+		noColor = os.Getenv("NO_COLOR") != "" || !stdoutIsTerminal()
+	default:
+		return fmt.Errorf("invalid --color %q: use auto, always, or never", mode)
+	}
+	if noColor {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	return nil
+}
+
 func style(s lipgloss.Style, text string) string {
 	if noColor {
 		return text
@@ -26,10 +91,63 @@ func style(s lipgloss.Style, text string) string {
 	return s.Render(text)
 }
 
+// SetQuiet controls whether Success messages are suppressed. Errors are
+// always printed regardless of this setting.
+func SetQuiet(enabled bool) {
+	quiet = enabled
+}
+
 func Success(msg string) {
+	if quiet {
+		return
+	}
 	fmt.Println(style(SuccessStyle, msg))
 }
 
+// Deleted writes the uniform JSON result of a delete command:
+// {"status":"deleted","id":"<id>","resource":"<resource>"}. Commands call it
+// in place of their success message when JSON output is requested.
+func Deleted(resource, id string) error {
+	return JSON(struct {
+		Status   string `json:"status"`
+		ID       string `json:"id"`
+		Resource string `json:"resource"`
+	}{"deleted", id, resource})
+}
+
+// OK writes {"status":"ok"}, the JSON result of a command whose request
+// succeeded without a response body (e.g. 204 No Content).
+func OK() error {
+	return JSON(struct {
+		Status string `json:"status"`
+	}{"ok"})
+}
+
+// RawJSON writes an API response body as JSON. An empty body, as sent with
+// 204 No Content, is written as OK's {"status":"ok"} rather than failing to
+// parse.
+func RawJSON(body []byte) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return OK()
+	}
+	var raw json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return JSON(raw)
+}
+
+// Note prints an informational msg to stderr, keeping stdout clean for
+// piping. Like Success, it is suppressed by SetQuiet.
+func Note(msg string) {
+	if quiet {
+		return
+	}
+	fmt.Fprintln(os.Stderr, msg)
+}
+
+// Error prints msg to stderr. It is deliberately not gated by SetQuiet so
+// that failures stay visible in scripts that silence success output.
 func Error(msg string) {
 	fmt.Fprintln(os.Stderr, style(ErrorStyle, msg))
 }
@@ -38,20 +156,158 @@ func Errorf(format string, args ...interface{}) {
 	Error(fmt.Sprintf(format, args...))
 }
 
+// SetEnvelope controls whether JSON wraps every payload in a uniform
+// {"data": ..., "meta": {...}} envelope.
+func SetEnvelope(enabled bool) {
+	envelope = enabled
+}
+
+// JSON writes v to stdout as indented JSON, wrapped in an envelope when
+// SetEnvelope(true) has been called. With --output yaml it writes YAML
+// instead.
 func JSON(v interface{}) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "  ")
-	return enc.Encode(v)
+	v, err := projectJSON(v)
+	if err != nil {
+		return err
+	}
+	if envelope {
+		wrapped, err := Envelope(v)
+		if err != nil {
+			return err
+		}
+		v = wrapped
+	}
+	if jsonPath != nil {
+		return writeJSONPath(v)
+	}
+	if format == "yaml" {
+		return YAML(v)
+	}
+	return writeJSON(v)
+}
+
+// JSONError writes an API error body to stdout as indented JSON, or as YAML
+// under --output yaml. Error bodies are never enveloped so scripts can tell
+// them apart from data.
+func JSONError(v interface{}) error {
+	if format == "yaml" {
+		return YAML(v)
+	}
+	return writeJSON(v)
+}
+
+// writeJSON encodes v to stdout, colorizing it when color is on and stdout
+// is a terminal so that piped JSON never contains escape codes.
+func writeJSON(v interface{}) error {
+	if noColor || !stdoutIsTerminal() {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", jsonIndent)
+		return enc.Encode(v)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", jsonIndent)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := fmt.Fprint(os.Stdout, colorizeJSON(buf.Bytes()))
+	return err
+}
+
+// SetIndent sets the indentation JSON output uses: a number of spaces from 0
+// (compact) to 8, or "tab".
+func SetIndent(value string) error {
+	if value == "tab" {
+		jsonIndent = "\t"
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > 8 {
+		return fmt.Errorf("invalid --indent %q: use a number of spaces from 0 to 8, or \"tab\"", value)
+	}
+	jsonIndent = strings.Repeat(" ", n)
+	return nil
+}
+
+// SetPageMeta sets the pagination metadata of the list being printed, such
+// as page, last_page, and total, for Envelope to include in meta. List
+// commands lose it when they reduce API responses to a bare array.
+func SetPageMeta(meta map[string]interface{}) {
+	pageMeta = meta
+}
+
+// Envelope normalizes v into a {"data": ..., "meta": {...}} object.
+// Arrays become data with a count in meta. API responses that already carry
+// a "data" key keep their data, and their "meta" and "links" are merged into
+// meta. Anything else is wrapped as data with empty meta. Metadata set with
+// SetPageMeta is added in every case.
+func Envelope(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+
+	meta := map[string]interface{}{}
+	for k, v := range pageMeta {
+		meta[k] = v
+	}
+
+	switch val := generic.(type) {
+	case []interface{}:
+		meta["count"] = len(val)
+		return map[string]interface{}{"data": val, "meta": meta}, nil
+	case nil:
+		meta["count"] = 0
+		return map[string]interface{}{"data": []interface{}{}, "meta": meta}, nil
+	case map[string]interface{}:
+		data, ok := val["data"]
+		if !ok {
+			break
+		}
+		if m, ok := val["meta"].(map[string]interface{}); ok {
+			for k, v := range m {
+				meta[k] = v
+			}
+		}
+		if links, ok := val["links"]; ok {
+			meta["links"] = links
+		}
+		if arr, ok := data.([]interface{}); ok {
+			if _, exists := meta["count"]; !exists {
+				meta["count"] = len(arr)
+			}
+		}
+		return map[string]interface{}{"data": data, "meta": meta}, nil
+	}
+
+	return map[string]interface{}{"data": generic, "meta": meta}, nil
 }
 
 func Table(headers []string, rows [][]string) {
+	if idsOnly {
+		printIDs(headers, rows)
+		return
+	}
+
 	if len(rows) == 0 {
 		fmt.Println(style(DimStyle, "No results found."))
 		return
 	}
 
+	headers, rows, err := selectColumns(headers, rows)
+	if err != nil {
+		renderErr = err
+		return
+	}
+
 	if noColor {
 		printPlainTable(headers, rows)
+		printSummary(len(rows))
 		return
 	}
 
@@ -61,7 +317,7 @@ func Table(headers []string, rows [][]string) {
 		Headers(headers...).
 		StyleFunc(func(row, col int) lipgloss.Style {
 			if row == table.HeaderRow {
-				return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Padding(0, 1)
+				return lipgloss.NewStyle().Bold(true).Foreground(primaryColor).Padding(0, 1)
 			}
 			return lipgloss.NewStyle().Padding(0, 1)
 		})
@@ -71,6 +327,122 @@ func Table(headers []string, rows [][]string) {
 	}
 
 	fmt.Println(t)
+	printSummary(len(rows))
+}
+
+// Fields renders v as a FIELD/VALUE view through Render. Nested objects are
+// flattened into dotted field names, arrays are shown as compact JSON, and
+// fields are sorted by name. Under -o json or -o yaml v is printed as it is.
+func Fields(v interface{}) error {
+	if format == "json" || format == "yaml" {
+		return JSON(v)
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return fmt.Errorf("cannot render %T as fields: %w", v, err)
+	}
+
+	flat := map[string]string{}
+	flattenFields("", obj, flat)
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, []string{k, flat[k]})
+	}
+	Render([]string{"FIELD", "VALUE"}, rows)
+	return nil
+}
+
+func flattenFields(prefix string, obj map[string]interface{}, into map[string]string) {
+	for k, v := range obj {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]interface{}:
+			flattenFields(key, val, into)
+		case string:
+			into[key] = val
+		case nil:
+			into[key] = ""
+		default:
+			b, _ := json.Marshal(val)
+			into[key] = string(b)
+		}
+	}
+}
+
+// SetSummaryNoun enables a count line such as "12 domains" on stderr after
+// each table, using singular for a count of one and plural otherwise. The
+// line is only written when stdout is a terminal and quiet mode is off.
+func SetSummaryNoun(singular, plural string) {
+	summarySingular = singular
+	summaryPlural = plural
+}
+
+// SetPageFooter replaces the count line after the next table with a page
+// footer such as "Page 2 of 17 (showing 25 of 412)". Unlike the count line
+// it is written whether or not stdout is a terminal, since it tells the
+// reader that more pages exist.
+func SetPageFooter(footer string) {
+	pageFooter = footer
+}
+
+func printSummary(n int) {
+	if pageFooter != "" {
+		if !quiet {
+			fmt.Fprintln(os.Stderr, style(DimStyle, pageFooter))
+		}
+		return
+	}
+	if summaryPlural == "" || quiet || !stdoutIsTerminal() {
+		return
+	}
+	noun := summaryPlural
+	if n == 1 {
+		noun = summarySingular
+	}
+	fmt.Fprintln(os.Stderr, style(DimStyle, fmt.Sprintf("%d %s", n, noun)))
+}
+
+// SetIDsOnly makes Table print only the ID column, one value per line, or
+// separated by NUL bytes when nul is true (for xargs -0).
+func SetIDsOnly(enabled, nul bool) {
+	idsOnly = enabled || nul
+	idSeparator = "\n"
+	if nul {
+		idSeparator = "\x00"
+	}
+}
+
+// printIDs writes the ID column of a table, or its first column if it has
+// none, each value followed by idSeparator.
+func printIDs(headers []string, rows [][]string) {
+	col := 0
+	for i, h := range headers {
+		if strings.EqualFold(h, "ID") {
+			col = i
+			break
+		}
+	}
+	var b strings.Builder
+	for _, row := range rows {
+		if col < len(row) {
+			b.WriteString(row[col])
+			b.WriteString(idSeparator)
+		}
+	}
+	fmt.Fprint(os.Stdout, b.String())
 }
 
 func printPlainTable(headers []string, rows [][]string) {
@@ -103,6 +475,167 @@ func printPlainTable(headers []string, rows [][]string) {
 	}
 }
 
+// IsTerminal reports whether f is attached to a terminal.
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// PagerCommand returns the pager to use: $MAILERSEND_PAGER, then $PAGER,
+// then less.
+func PagerCommand() string {
+	if p := os.Getenv("MAILERSEND_PAGER"); p != "" {
+		return p
+	}
+	if p := os.Getenv("PAGER"); p != "" {
+		return p
+	}
+	return "less"
+}
+
+// StartPager starts command and redirects os.Stdout into its stdin. The
+// returned stop function restores os.Stdout and waits for the pager to exit;
+// it must be called once all output has been written.
+func StartPager(command string) (func(), error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return func() {}, nil
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pager pipe: %w", err)
+	}
+
+	pager := exec.Command(args[0], args[1:]...) //nolint:gosec // pager is chosen by the user
+	pager.Stdin = r
+	pager.Stdout = os.Stdout
+	pager.Stderr = os.Stderr
+	pager.Env = os.Environ()
+	if os.Getenv("LESS") == "" {
+		// Quit if one screen, keep colors, don't clear the screen on exit.
+		pager.Env = append(pager.Env, "LESS=FRX")
+	}
+
+	if err := pager.Start(); err != nil {
+		r.Close() //nolint:errcheck
+		w.Close() //nolint:errcheck
+		return nil, fmt.Errorf("failed to start pager %q: %w", args[0], err)
+	}
+	r.Close() //nolint:errcheck
+
+	orig := os.Stdout
+	os.Stdout = w
+	return func() {
+		os.Stdout = orig
+		w.Close() //nolint:errcheck
+		_ = pager.Wait()
+	}, nil
+}
+
+// SetRelativeTime controls whether FormatTime and FormatTimeString render
+// timestamps relative to now ("3 days ago") instead of absolute.
+func SetRelativeTime(enabled bool) {
+	relativeTime = enabled
+}
+
+// SetTimeFormat sets how FormatTime renders timestamps: "rfc3339",
+// "local" (the default layout in the local time zone), "unix" (seconds), or
+// a Go layout string such as "Jan 2 15:04". An empty value keeps each
+// command's default layout.
+func SetTimeFormat(value string) error {
+	switch value {
+	case "", "rfc3339", "local", "unix":
+	default:
+		sample := time.Date(1999, 11, 28, 7, 8, 9, 0, time.UTC)
+		if sample.Format(value) == value {
+			return fmt.Errorf("invalid --time-format %q: use rfc3339, local, unix, or a Go layout like \"2006-01-02 15:04\"", value)
+		}
+	}
+	timeFormat = value
+	return nil
+}
+
+// FormatTime formats t with layout, or as selected by SetTimeFormat, or
+// relative to now under SetRelativeTime(true). The zero time renders as an
+// empty string.
+func FormatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	if relativeTime {
+		return Humanize(t)
+	}
+	switch timeFormat {
+	case "":
+		return t.Format(layout)
+	case "rfc3339":
+		return t.Format(time.RFC3339)
+	case "local":
+		return t.Local().Format("2006-01-02 15:04:05")
+	case "unix":
+		return strconv.FormatInt(t.Unix(), 10)
+	default:
+		return t.Format(timeFormat)
+	}
+}
+
+// FormatTimeString is FormatTime for timestamps the API returns as strings.
+// Unparseable values, and all values when neither relative time nor a time
+// format is set, are returned unchanged.
+func FormatTimeString(s string) string {
+	if (!relativeTime && timeFormat == "") || s == "" {
+		return s
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return FormatTime(t, layout)
+		}
+	}
+	return s
+}
+
+// Humanize renders t relative to now, e.g. "5 minutes ago" or "in 2 days".
+func Humanize(t time.Time) string {
+	return humanizeSince(t, time.Now())
+}
+
+func humanizeSince(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var n int
+	var unit string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		n, unit = int(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		n, unit = int(d/time.Hour), "hour"
+	case d < 30*24*time.Hour:
+		n, unit = int(d/(24*time.Hour)), "day"
+	case d < 365*24*time.Hour:
+		n, unit = int(d/(30*24*time.Hour)), "month"
+	default:
+		n, unit = int(d/(365*24*time.Hour)), "year"
+	}
+	if n != 1 {
+		unit += "s"
+	}
+
+	if future {
+		return fmt.Sprintf("in %d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %s ago", n, unit)
+}
+
 func Truncate(s string, max int) string {
 	if len(s) <= max {
 		return s
@@ -4,7 +4,13 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestTruncate_ShorterThanMax(t *testing.T) {
@@ -62,3 +68,403 @@ func TestJSON_OutputsValidJSON(t *testing.T) {
 		t.Fatalf("expected key=value, got key=%s", parsed["key"])
 	}
 }
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = origStdout }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return out
+}
+
+// captureStderr is captureStdout for os.Stderr.
+func captureStderr(t *testing.T, fn func()) []byte {
+	t.Helper()
+	origStderr := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	os.Stderr = w
+	defer func() { os.Stderr = origStderr }()
+
+	fn()
+
+	w.Close() //nolint:errcheck
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return out
+}
+
+func TestEnvelope_ListWrapsArrayWithCount(t *testing.T) {
+	SetEnvelope(true)
+	defer SetEnvelope(false)
+
+	list := []map[string]string{{"id": "d1"}, {"id": "d2"}}
+	out := captureStdout(t, func() {
+		if err := JSON(list); err != nil {
+			t.Fatalf("JSON() returned error: %v", err)
+		}
+	})
+
+	var parsed struct {
+		Data []map[string]string    `json:"data"`
+		Meta map[string]interface{} `json:"meta"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatalf("output is not valid JSON: %v\noutput: %s", err, string(out))
+	}
+	if len(parsed.Data) != 2 || parsed.Data[1]["id"] != "d2" {
+		t.Fatalf("expected 2 items in data, got %v", parsed.Data)
+	}
+	if parsed.Meta["count"] != float64(2) {
+		t.Fatalf("expected meta.count=2, got %v", parsed.Meta["count"])
+	}
+}
+
+func TestEnvelope_GetKeepsDataAndMergesMetaAndLinks(t *testing.T) {
+	resp := map[string]interface{}{
+		"data":  map[string]string{"id": "d1", "name": "example.com"},
+		"links": map[string]string{"next": ""},
+		"meta":  map[string]interface{}{"current_page": 1},
+	}
+
+	got, err := Envelope(resp)
+	if err != nil {
+		t.Fatalf("Envelope() returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected only data and meta keys, got %v", got)
+	}
+	data, ok := got["data"].(map[string]interface{})
+	if !ok || data["id"] != "d1" {
+		t.Fatalf("expected data to be the inner object, got %v", got["data"])
+	}
+	meta := got["meta"].(map[string]interface{})
+	if meta["current_page"] != float64(1) {
+		t.Fatalf("expected meta.current_page=1, got %v", meta["current_page"])
+	}
+	if _, ok := meta["links"]; !ok {
+		t.Fatal("expected links to be merged into meta")
+	}
+}
+
+func TestEnvelope_PlainObjectWrapped(t *testing.T) {
+	got, err := Envelope(map[string]string{"status": "sent"})
+	if err != nil {
+		t.Fatalf("Envelope() returned error: %v", err)
+	}
+	data, ok := got["data"].(map[string]interface{})
+	if !ok || data["status"] != "sent" {
+		t.Fatalf("expected plain object wrapped as data, got %v", got["data"])
+	}
+	if meta, ok := got["meta"].(map[string]interface{}); !ok || len(meta) != 0 {
+		t.Fatalf("expected empty meta, got %v", got["meta"])
+	}
+}
+
+func TestSetColors_CustomSuccessColorApplied(t *testing.T) {
+	orig := SuccessStyle
+	defer func() { SuccessStyle = orig }()
+
+	SetColors("", "#00ff88", "")
+
+	if got := SuccessStyle.GetForeground(); got != lipgloss.Color("#00ff88") {
+		t.Fatalf("expected success foreground #00ff88, got %v", got)
+	}
+}
+
+func TestStartPager_RoutesStdoutThroughPager(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake pager is a shell script")
+	}
+
+	dir := t.TempDir()
+	captured := filepath.Join(dir, "paged.txt")
+	script := filepath.Join(dir, "fake-pager")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\ncat > \""+captured+"\"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	stop, err := StartPager(script)
+	if err != nil {
+		t.Fatalf("StartPager() returned error: %v", err)
+	}
+	Table([]string{"ID"}, [][]string{{"paged-row"}})
+	stop()
+
+	data, err := os.ReadFile(captured)
+	if err != nil {
+		t.Fatalf("pager did not receive output: %v", err)
+	}
+	if !strings.Contains(string(data), "paged-row") {
+		t.Fatalf("expected table output in pager, got %q", string(data))
+	}
+}
+
+func TestPagerCommand_Precedence(t *testing.T) {
+	t.Setenv("MAILERSEND_PAGER", "")
+	t.Setenv("PAGER", "")
+	if got := PagerCommand(); got != "less" {
+		t.Errorf("expected default less, got %q", got)
+	}
+
+	t.Setenv("PAGER", "more")
+	if got := PagerCommand(); got != "more" {
+		t.Errorf("expected $PAGER, got %q", got)
+	}
+
+	t.Setenv("MAILERSEND_PAGER", "most")
+	if got := PagerCommand(); got != "most" {
+		t.Errorf("expected $MAILERSEND_PAGER to win, got %q", got)
+	}
+}
+
+func TestHumanizeSince_Intervals(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		offset time.Duration
+		want   string
+	}{
+		{10 * time.Second, "just now"},
+		{time.Minute, "1 minute ago"},
+		{45 * time.Minute, "45 minutes ago"},
+		{3 * time.Hour, "3 hours ago"},
+		{24 * time.Hour, "1 day ago"},
+		{3 * 24 * time.Hour, "3 days ago"},
+		{65 * 24 * time.Hour, "2 months ago"},
+		{400 * 24 * time.Hour, "1 year ago"},
+		{-2 * time.Hour, "in 2 hours"},
+	}
+	for _, tt := range tests {
+		if got := humanizeSince(now.Add(-tt.offset), now); got != tt.want {
+			t.Errorf("humanizeSince(now-%s) = %q, want %q", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestFormatTimeString_RelativeToggle(t *testing.T) {
+	defer SetRelativeTime(false)
+
+	ts := time.Now().Add(-3 * 24 * time.Hour).UTC()
+	for _, s := range []string{ts.Format(time.RFC3339), ts.Format("2006-01-02 15:04:05")} {
+		SetRelativeTime(false)
+		if got := FormatTimeString(s); got != s {
+			t.Errorf("expected absolute %q unchanged, got %q", s, got)
+		}
+		SetRelativeTime(true)
+		if got := FormatTimeString(s); got != "3 days ago" {
+			t.Errorf("expected %q to render as 3 days ago, got %q", s, got)
+		}
+	}
+
+	if got := FormatTimeString("not a time"); got != "not a time" {
+		t.Errorf("expected unparseable value unchanged, got %q", got)
+	}
+	if got := FormatTime(time.Time{}, time.RFC3339); got != "" {
+		t.Errorf("expected zero time to render empty, got %q", got)
+	}
+}
+
+func TestFormatTime_TimeFormats(t *testing.T) {
+	defer SetTimeFormat("") //nolint:errcheck
+
+	ts := time.Date(2024, 3, 9, 14, 5, 6, 0, time.UTC)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"", "2024-03-09 14:05:06"},
+		{"rfc3339", "2024-03-09T14:05:06Z"},
+		{"local", ts.Local().Format("2006-01-02 15:04:05")},
+		{"unix", "1709993106"},
+		{"Jan 2 15:04", "Mar 9 14:05"},
+	}
+	for _, tt := range tests {
+		if err := SetTimeFormat(tt.format); err != nil {
+			t.Fatalf("SetTimeFormat(%q) error: %v", tt.format, err)
+		}
+		if got := FormatTime(ts, "2006-01-02 15:04:05"); got != tt.want {
+			t.Errorf("FormatTime with %q = %q, want %q", tt.format, got, tt.want)
+		}
+		if got := FormatTimeString("2024-03-09T14:05:06Z"); tt.format != "" && got != tt.want {
+			t.Errorf("FormatTimeString with %q = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	if err := SetTimeFormat("iso"); err == nil {
+		t.Error("expected error for a format with no layout elements")
+	}
+}
+
+func TestTable_SummaryLineOnStderr(t *testing.T) {
+	origTerminal := stdoutIsTerminal
+	stdoutIsTerminal = func() bool { return true }
+	defer func() { stdoutIsTerminal = origTerminal }()
+	SetSummaryNoun("domain", "domains")
+	defer SetSummaryNoun("", "")
+
+	rows := [][]string{{"d1", "a.com"}, {"d2", "b.com"}}
+	var stderr []byte
+	captureStdout(t, func() {
+		stderr = captureStderr(t, func() { Table([]string{"ID", "NAME"}, rows) })
+	})
+	if got := strings.TrimSpace(string(stderr)); got != "2 domains" {
+		t.Errorf("expected \"2 domains\" on stderr, got %q", got)
+	}
+
+	captureStdout(t, func() {
+		stderr = captureStderr(t, func() { Table([]string{"ID", "NAME"}, rows[:1]) })
+	})
+	if got := strings.TrimSpace(string(stderr)); got != "1 domain" {
+		t.Errorf("expected singular \"1 domain\" on stderr, got %q", got)
+	}
+
+	// JSON output never prints the count line.
+	captureStdout(t, func() {
+		stderr = captureStderr(t, func() { _ = JSON([]string{"a", "b"}) })
+	})
+	if len(stderr) != 0 {
+		t.Errorf("expected no stderr under JSON output, got %q", stderr)
+	}
+
+	// Neither does quiet mode.
+	SetQuiet(true)
+	defer SetQuiet(false)
+	captureStdout(t, func() {
+		stderr = captureStderr(t, func() { Table([]string{"ID", "NAME"}, rows) })
+	})
+	if len(stderr) != 0 {
+		t.Errorf("expected no stderr under quiet mode, got %q", stderr)
+	}
+}
+
+func TestSetIndent_Width(t *testing.T) {
+	defer SetIndent("2") //nolint:errcheck
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"4", "{\n    \"id\": \"d1\"\n}\n"},
+		{"tab", "{\n\t\"id\": \"d1\"\n}\n"},
+		{"0", "{\"id\":\"d1\"}\n"},
+	}
+	for _, tt := range tests {
+		if err := SetIndent(tt.value); err != nil {
+			t.Fatalf("SetIndent(%q): %v", tt.value, err)
+		}
+		out := captureStdout(t, func() { _ = JSON(map[string]string{"id": "d1"}) })
+		if string(out) != tt.want {
+			t.Errorf("--indent %s: got %q, want %q", tt.value, out, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"-1", "9", "two", ""} {
+		if err := SetIndent(bad); err == nil {
+			t.Errorf("expected error for --indent %q", bad)
+		}
+	}
+}
+
+func TestTable_IDsOnly(t *testing.T) {
+	defer SetIDsOnly(false, false)
+
+	headers := []string{"NAME", "ID"}
+	rows := [][]string{{"a.com", "d1"}, {"b.com", "d 2"}}
+
+	SetIDsOnly(true, false)
+	if got := string(captureStdout(t, func() { Table(headers, rows) })); got != "d1\nd 2\n" {
+		t.Errorf("--ids-only: got %q", got)
+	}
+
+	SetIDsOnly(false, true)
+	if got := string(captureStdout(t, func() { Table(headers, rows) })); got != "d1\x00d 2\x00" {
+		t.Errorf("--print0: expected NUL separators, got %q", got)
+	}
+}
+
+func TestSetColorMode(t *testing.T) {
+	origNoColor, origProfile, origTerminal := noColor, lipgloss.ColorProfile(), stdoutIsTerminal
+	defer func() {
+		noColor, stdoutIsTerminal = origNoColor, origTerminal
+		lipgloss.SetColorProfile(origProfile)
+	}()
+
+	tests := []struct {
+		mode     string
+		terminal bool
+		noColor  string
+		want     bool // colored
+	}{
+		{"auto", true, "", true},
+		{"auto", false, "", false},
+		{"auto", true, "1", false},
+		{"always", false, "1", true},
+		{"never", true, "", false},
+	}
+	for _, tt := range tests {
+		t.Setenv("NO_COLOR", tt.noColor)
+		terminal := tt.terminal
+		stdoutIsTerminal = func() bool { return terminal }
+		if err := SetColorMode(tt.mode); err != nil {
+			t.Fatalf("SetColorMode(%q) error: %v", tt.mode, err)
+		}
+		// Under auto, lipgloss detects the real terminal's color support
+		// itself, so only check that styling is not disabled.
+		colored := !noColor
+		if tt.mode != "auto" {
+			colored = strings.Contains(style(SuccessStyle, "ok"), "\x1b[")
+		}
+		if colored != tt.want {
+			t.Errorf("mode=%s terminal=%v NO_COLOR=%q: colored = %v, want %v", tt.mode, tt.terminal, tt.noColor, colored, tt.want)
+		}
+	}
+
+	if err := SetColorMode("sometimes"); err == nil {
+		t.Error("expected error for unknown color mode")
+	}
+}
+
+func TestJSON_ColorsOnlyOnTerminal(t *testing.T) {
+	origNoColor, origProfile, origTerminal := noColor, lipgloss.ColorProfile(), stdoutIsTerminal
+	defer func() {
+		noColor, stdoutIsTerminal = origNoColor, origTerminal
+		lipgloss.SetColorProfile(origProfile)
+	}()
+	v := map[string]interface{}{"name": "a \"quoted\" value", "count": 2, "ok": true, "tags": nil}
+
+	noColor, stdoutIsTerminal = false, func() bool { return false }
+	plain := captureStdout(t, func() { JSON(v) }) //nolint:errcheck
+	if strings.Contains(string(plain), "\x1b") {
+		t.Fatalf("piped JSON contains escape codes: %q", plain)
+	}
+
+	if err := SetColorMode("always"); err != nil {
+		t.Fatal(err)
+	}
+	stdoutIsTerminal = func() bool { return true }
+	colored := captureStdout(t, func() { JSON(v) }) //nolint:errcheck
+	if !strings.Contains(string(colored), "\x1b") {
+		t.Fatalf("terminal JSON is not colored: %q", colored)
+	}
+	if StripANSI(string(colored)) != string(plain) {
+		t.Errorf("colored JSON differs from plain JSON once stripped:\n%s\n%s", StripANSI(string(colored)), plain)
+	}
+}
@@ -0,0 +1,59 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Raw copies r to path byte for byte, or to stdout when path is "" or "-".
+// It is the write path for download-style commands whose responses are not
+// JSON (raw emails, attachments), so nothing is decoded, styled, or paged.
+// A partially written file is removed if the copy fails.
+func Raw(r io.Reader, path string) error {
+	if path == "" || path == "-" {
+		_, err := io.Copy(os.Stdout, r)
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()       //nolint:errcheck
+		os.Remove(path) //nolint:errcheck
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	return nil
+}
+
+// StartOutputFile redirects os.Stdout to a new file at path for
+// --output-file, so that whatever a command prints, including raw bytes
+// written by Raw, lands there unchanged. The returned stop function restores
+// os.Stdout and closes the file; when failed is true the partial file is
+// removed, as Raw does.
+func StartOutputFile(path string) (func(failed bool) error, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	orig := os.Stdout
+	os.Stdout = f
+	return func(failed bool) error {
+		os.Stdout = orig
+		err := f.Close()
+		if failed {
+			os.Remove(path) //nolint:errcheck
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRaw_FileIsByteForByte(t *testing.T) {
+	data := make([]byte, 4096)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	data = append(data, 0x00, '\r', '\n', 0xff, 0xfe)
+
+	path := filepath.Join(t.TempDir(), "download.bin")
+	if err := Raw(bytes.NewReader(data), path); err != nil {
+		t.Fatalf("Raw() error: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("file content differs from input (%d bytes written, %d expected)", len(got), len(data))
+	}
+}
+
+func TestRaw_Stdout(t *testing.T) {
+	data := []byte{0x89, 'P', 'N', 'G', 0x00, 0x1a}
+
+	out := captureStdout(t, func() {
+		if err := Raw(bytes.NewReader(data), "-"); err != nil {
+			t.Fatalf("Raw() error: %v", err)
+		}
+	})
+
+	if !bytes.Equal(out, data) {
+		t.Errorf("stdout = %q, want %q", out, data)
+	}
+}
+
+func TestStartOutputFile(t *testing.T) {
+	data := []byte{0x89, 'P', 'N', 'G', 0x00, '\r', '\n', 0xff}
+	path := filepath.Join(t.TempDir(), "out.bin")
+
+	orig := os.Stdout
+	stop, err := StartOutputFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Raw(bytes.NewReader(data), ""); err != nil {
+		t.Fatalf("Raw() error: %v", err)
+	}
+	if err := stop(false); err != nil {
+		t.Fatalf("stop() error: %v", err)
+	}
+	if os.Stdout != orig {
+		t.Error("os.Stdout was not restored")
+	}
+	if got, _ := os.ReadFile(path); !bytes.Equal(got, data) {
+		t.Errorf("file = %q, want %q", got, data)
+	}
+
+	stop, err = StartOutputFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(true); err != nil {
+		t.Fatalf("stop() error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the file of a failed command removed, got %v", err)
+	}
+}
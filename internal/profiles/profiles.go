@@ -0,0 +1,146 @@
+// Package profiles implements the profile commands shared by "profile" and
+// "config profile". Each function has the signature of a cobra RunE.
+package profiles
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mailersend/mailersend-cli/internal/cmdutil"
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/prompt"
+	"github.com/spf13/cobra"
+)
+
+// List lists the configured profiles with their auth method and masked
+// token.
+func List(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(cfg.Profiles))
+	for n := range cfg.Profiles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	if cmdutil.JSONFlag(cmd) {
+		profiles := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			p := cfg.Profiles[name]
+			profiles = append(profiles, map[string]interface{}{
+				"name":      name,
+				"active":    name == cfg.ActiveProfile,
+				"method":    p.Method(),
+				"token":     maskedToken(p),
+				"has_token": p.APIToken != "",
+				"has_oauth": p.OAuthToken != "",
+			})
+		}
+		return output.JSON(profiles)
+	}
+
+	if len(names) == 0 {
+		fmt.Println("No profiles configured. Run 'mailersend profile add <name>' to create one.")
+		return nil
+	}
+
+	var rows [][]string
+	for _, name := range names {
+		p := cfg.Profiles[name]
+		active := ""
+		if name == cfg.ActiveProfile {
+			active = "*"
+		}
+		rows = append(rows, []string{active, name, p.Method(), maskedToken(p)})
+	}
+
+	output.Render([]string{"", "NAME", "METHOD", "TOKEN"}, rows)
+	return nil
+}
+
+// maskedToken returns the masked credential GetToken would use for p.
+func maskedToken(p config.Profile) string {
+	return config.MaskToken(p.Token())
+}
+
+// Switch makes the profile named by args[0] the active one.
+func Switch(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := cfg.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	cfg.ActiveProfile = name
+	if err := config.Save(cfg); err != nil {
+		return err
+	}
+
+	output.Success(fmt.Sprintf("Switched to profile: %s", name))
+	return nil
+}
+
+// Rename renames the profile args[0] to args[1], keeping it active if it
+// was.
+func Rename(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.RenameProfile(args[0], args[1]); err != nil {
+		return err
+	}
+
+	if err := config.Save(cfg); err != nil {
+		return err
+	}
+
+	output.Success(fmt.Sprintf("Profile %q renamed to %q.", args[0], args[1]))
+	return nil
+}
+
+// Remove deletes the profile named by args[0] after confirming on a
+// terminal.
+func Remove(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := cfg.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	if prompt.IsInteractive() {
+		ok, err := prompt.Confirm(fmt.Sprintf("Remove profile %q?", name))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	if err := cfg.RemoveProfile(name); err != nil {
+		return err
+	}
+
+	if err := config.Save(cfg); err != nil {
+		return err
+	}
+
+	output.Success(fmt.Sprintf("Profile %q removed.", name))
+	return nil
+}
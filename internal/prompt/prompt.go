@@ -1,6 +1,7 @@
 package prompt
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -96,3 +97,28 @@ func RequireSliceArg(values []string, flag, label string) ([]string, error) {
 	}
 	return result, nil
 }
+
+// ErrCancelled is returned by ConfirmDestructive when the user declines.
+// The command exits non-zero, and ReportError prints "Cancelled." for it.
+var ErrCancelled = errors.New("cancelled")
+
+// ConfirmDestructive asks the user to confirm an irreversible action. yes is
+// the command's --yes flag and skips the prompt; without a terminal to ask
+// on, it is an error rather than a silent go-ahead. Declining returns
+// ErrCancelled.
+func ConfirmDestructive(yes bool, label string) error {
+	if yes {
+		return nil
+	}
+	if !IsInteractive() {
+		return fmt.Errorf("%s: pass --yes to confirm when not running interactively", strings.TrimSuffix(label, "?"))
+	}
+	ok, err := Confirm(label)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrCancelled
+	}
+	return nil
+}
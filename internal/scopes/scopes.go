@@ -0,0 +1,111 @@
+// Package scopes describes the permissions an API token can be granted.
+package scopes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scope is an API token permission.
+type Scope struct {
+	Name        string `json:"name"`
+	Resource    string `json:"resource"`
+	Description string `json:"description"`
+}
+
+// All lists the token scopes, grouped by resource. `token scopes` prints it
+// and `auth login` requests its full scopes.
+var All = []Scope{
+	{"email_full", "Email", "Send emails and manage scheduled messages"},
+	{"domains_read", "Domains", "View domains and their DNS records"},
+	{"domains_full", "Domains", "Add, update, verify, and delete domains"},
+	{"activity_read", "Activity", "View email activity"},
+	{"activity_full", "Activity", "Full access to email activity"},
+	{"analytics_read", "Analytics", "View analytics"},
+	{"analytics_full", "Analytics", "Full access to analytics"},
+	{"tokens_full", "API tokens", "Create, update, and delete API tokens"},
+	{"webhooks_full", "Webhooks", "Create, update, and delete webhooks"},
+	{"templates_full", "Templates", "View and delete templates"},
+	{"inbounds_full", "Inbound routes", "Create, update, and delete inbound routes"},
+	{"suppressions_read", "Suppressions", "View suppression lists"},
+	{"suppressions_full", "Suppressions", "Add and remove suppression entries"},
+	{"sms_read", "SMS", "View SMS messages, numbers, and activity"},
+	{"sms_full", "SMS", "Send SMS and manage numbers, webhooks, and inbound routes"},
+	{"email_verification_read", "Email verification", "View verification lists and results"},
+	{"email_verification_full", "Email verification", "Verify addresses and manage verification lists"},
+	{"recipients_read", "Recipients", "View recipients"},
+	{"recipients_full", "Recipients", "View and delete recipients"},
+	{"sender_identity_read", "Sender identities", "View sender identities"},
+	{"sender_identity_full", "Sender identities", "Create, update, and delete sender identities"},
+	{"smtp_users_full", "SMTP users", "Create, update, and delete SMTP users"},
+	{"users_full", "Account users", "Invite, update, and remove account users"},
+	{"dmarc_monitoring_full", "DMARC monitoring", "Manage DMARC monitoring"},
+}
+
+// Full returns the name of every full-access scope.
+func Full() []string {
+	var names []string
+	for _, s := range All {
+		if strings.HasSuffix(s.Name, "_full") {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// Validate returns an error naming each scope that is not in All, with the
+// closest known scope as a suggestion.
+func Validate(scopes []string) error {
+	known := map[string]bool{}
+	for _, s := range All {
+		known[s.Name] = true
+	}
+	var problems []string
+	for _, scope := range scopes {
+		if known[scope] {
+			continue
+		}
+		if match := closestScope(scope); match != "" {
+			problems = append(problems, fmt.Sprintf("%q (did you mean %q?)", scope, match))
+		} else {
+			problems = append(problems, fmt.Sprintf("%q", scope))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unknown scopes: %s; run `mailersend token scopes` for the list, or pass --allow-unknown-scopes", strings.Join(problems, ", "))
+}
+
+// closestScope returns the known scope nearest to name by edit distance, or
+// "" when none is close enough to be a likely typo.
+func closestScope(name string) string {
+	best, bestDist := "", len(name)/2+1
+	for _, s := range All {
+		if d := editDistance(name, s.Name); d < bestDist {
+			best, bestDist = s.Name, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,25 @@
+package scopes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFull(t *testing.T) {
+	if got := len(Full()); got != 16 {
+		t.Errorf("Full returned %d scopes, want 16", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate([]string{"email_full", "domains_read"}); err != nil {
+		t.Errorf("Validate(known) = %v, want nil", err)
+	}
+	err := Validate([]string{"email_ful", "nonsense_scope_name"})
+	if err == nil {
+		t.Fatal("expected an error for unknown scopes")
+	}
+	if !strings.Contains(err.Error(), `"email_ful" (did you mean "email_full"?)`) || !strings.Contains(err.Error(), `"nonsense_scope_name"`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
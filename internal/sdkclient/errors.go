@@ -1,11 +1,13 @@
 package sdkclient
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/mailersend/mailersend-go"
@@ -19,19 +21,24 @@ type CLIError struct {
 	RawBody    json.RawMessage     `json:"-"`
 }
 
+// Error lists field-level errors sorted by field name, so the message is
+// the same on every run.
 func (e *CLIError) Error() string {
 	if len(e.Errors) > 0 {
 		maxLen := 0
+		fields := make([]string, 0, len(e.Errors))
 		for field := range e.Errors {
+			fields = append(fields, field)
 			if len(field) > maxLen {
 				maxLen = len(field)
 			}
 		}
+		sort.Strings(fields)
 
 		var b strings.Builder
 		fmt.Fprintf(&b, "API error %d: %s\n", e.StatusCode, e.Message)
-		for field, msgs := range e.Errors {
-			for _, msg := range msgs {
+		for _, field := range fields {
+			for _, msg := range e.Errors[field] {
 				fmt.Fprintf(&b, "\n  %-*s  %s", maxLen, field, msg)
 			}
 		}
@@ -76,21 +83,8 @@ func WrapError(err error) error {
 	// Try to parse the captured response body for field-level errors.
 	if httpResp != nil && httpResp.Header != nil {
 		if encodedBody := httpResp.Header.Get("X-CLI-Error-Body"); encodedBody != "" {
-			if rawBody, decErr := base64.StdEncoding.DecodeString(encodedBody); decErr == nil && len(rawBody) > 0 {
-				cliErr.RawBody = json.RawMessage(rawBody)
-
-				var parsed struct {
-					Message string              `json:"message"`
-					Errors  map[string][]string `json:"errors"`
-				}
-				if json.Unmarshal(rawBody, &parsed) == nil {
-					if parsed.Message != "" {
-						cliErr.Message = parsed.Message
-					}
-					if len(parsed.Errors) > 0 {
-						cliErr.Errors = parsed.Errors
-					}
-				}
+			if rawBody, decErr := base64.StdEncoding.DecodeString(encodedBody); decErr == nil {
+				cliErr.parseBody(rawBody)
 			}
 		}
 	}
@@ -101,3 +95,39 @@ func WrapError(err error) error {
 
 	return cliErr
 }
+
+// ResponseError builds a CLIError from the status and body of an error
+// response to a raw request, as WrapError does for SDK calls.
+func ResponseError(statusCode int, body []byte) *CLIError {
+	cliErr := &CLIError{StatusCode: statusCode}
+	cliErr.parseBody(body)
+	if cliErr.Message == "" {
+		cliErr.Message = string(bytes.TrimSpace(body))
+	}
+	if cliErr.Message == "" {
+		cliErr.Message = http.StatusText(statusCode)
+	}
+	return cliErr
+}
+
+// parseBody fills RawBody, and Message and Errors when present, from an API
+// error response body.
+func (e *CLIError) parseBody(rawBody []byte) {
+	if len(rawBody) == 0 {
+		return
+	}
+	var parsed struct {
+		Message string              `json:"message"`
+		Errors  map[string][]string `json:"errors"`
+	}
+	if json.Unmarshal(rawBody, &parsed) != nil {
+		return
+	}
+	e.RawBody = json.RawMessage(rawBody)
+	if parsed.Message != "" {
+		e.Message = parsed.Message
+	}
+	if len(parsed.Errors) > 0 {
+		e.Errors = parsed.Errors
+	}
+}
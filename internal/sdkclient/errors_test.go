@@ -0,0 +1,28 @@
+package sdkclient
+
+import "testing"
+
+func TestCLIError_FieldOrderIsStable(t *testing.T) {
+	err := &CLIError{
+		StatusCode: 422,
+		Message:    "The given data was invalid.",
+		Errors: map[string][]string{
+			"to.0.email": {"The email must be a valid email address."},
+			"from.email": {"The from.email must be verified."},
+			"subject":    {"The subject field is required."},
+			"html":       {"The html field is required.", "Or provide text."},
+		},
+	}
+
+	want := "API error 422: The given data was invalid.\n" +
+		"\n  from.email  The from.email must be verified." +
+		"\n  html        The html field is required." +
+		"\n  html        Or provide text." +
+		"\n  subject     The subject field is required." +
+		"\n  to.0.email  The email must be a valid email address."
+	for i := 0; i < 20; i++ {
+		if got := err.Error(); got != want {
+			t.Fatalf("run %d: Error() =\n%s\nwant\n%s", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package sdkclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/mailersend/mailersend-go"
+)
+
+// OnHoldEntry is one item on the on-hold suppression list.
+type OnHoldEntry struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Pattern   string `json:"pattern"`
+	Recipient struct {
+		Email string `json:"email"`
+	} `json:"recipient"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ListOnHold fetches on-hold entries, optionally filtered by domain ID, up to
+// limit (0 = all). The SDK does not have dedicated on-hold endpoints, so this
+// issues raw requests via the SDK's HTTP client, which includes the CLI
+// transport for retries, verbose logging, and base URL rewrite.
+func ListOnHold(ctx context.Context, ms *mailersend.Mailersend, domainID string, limit int) ([]OnHoldEntry, error) {
+	return FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]OnHoldEntry, bool, error) {
+		url := fmt.Sprintf("https://api.mailersend.com/v1/suppressions/on-hold-list?page=%d&limit=%d", page, perPage)
+		if domainID != "" {
+			url += "&domain_id=" + domainID
+		}
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		req.Header.Set("Authorization", "Bearer "+ms.APIKey())
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := ms.Client().Do(req)
+		if err != nil {
+			return nil, false, err
+		}
+		defer resp.Body.Close() //nolint:errcheck
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, false, ResponseError(resp.StatusCode, body)
+		}
+
+		var parsed struct {
+			Data  []OnHoldEntry `json:"data"`
+			Links struct {
+				Next string `json:"next"`
+			} `json:"links"`
+		}
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			return nil, false, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return parsed.Data, parsed.Links.Next != "", nil
+	}, limit)
+}
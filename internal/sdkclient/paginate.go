@@ -1,15 +1,58 @@
 package sdkclient
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
 
 // PageFetcher fetches a single page of results. Returns the items, whether
 // there is a next page, and any error.
 type PageFetcher[T any] func(ctx context.Context, page, perPage int) ([]T, bool, error)
 
+// defaultConcurrency is how many pages FetchAll requests at once after the
+// first page.
+const defaultConcurrency = 4
+
+var concurrency = defaultConcurrency
+
+// SetSequential makes FetchAll request one page at a time, for accounts
+// that hit rate limits with concurrent requests.
+func SetSequential(enabled bool) {
+	if enabled {
+		concurrency = 1
+	} else {
+		concurrency = defaultConcurrency
+	}
+}
+
+// pageResult is the outcome of fetching one page.
+type pageResult[T any] struct {
+	items   []T
+	hasNext bool
+	err     error
+}
+
 // FetchAll fetches all pages up to limit using the given PageFetcher.
 // If limit is 0, all pages are fetched. Same pagination logic as the
 // old api.Client.GetPaginated.
+//
+// When the first response reports meta.last_page, the remaining pages up to
+// it are requested concurrently, up to four at a time (see SetSequential).
+// Endpoints without that metadata are read in speculative batches of four,
+// discarding pages past the last one. Either way pages are reassembled in
+// order, and an error on any page cancels the requests still in flight.
 func FetchAll[T any](ctx context.Context, fetch PageFetcher[T], limit int) ([]T, error) {
+	items, _, err := FetchAllMeta(ctx, fetch, limit)
+	return items, err
+}
+
+// FetchAllMeta is FetchAll that also returns the pagination metadata of the
+// first response, for the total it reports.
+func FetchAllMeta[T any](ctx context.Context, fetch PageFetcher[T], limit int) ([]T, *PageMeta, error) {
 	perPage := 25
 	if limit > 0 && limit < perPage {
 		perPage = limit
@@ -19,27 +62,190 @@ func FetchAll[T any](ctx context.Context, fetch PageFetcher[T], limit int) ([]T,
 		perPage = 10
 	}
 
-	var allItems []T
-	page := 1
+	meta := &PageMeta{CurrentPage: 1, PerPage: perPage}
+	allItems, hasNext, err := fetch(context.WithValue(ctx, pageMetaKey{}, meta), 1, perPage)
+	if err != nil {
+		return nil, nil, err
+	}
+	if limit > 0 && len(allItems) >= limit {
+		return allItems[:limit], meta, nil
+	}
+	if !hasNext {
+		return allItems, meta, nil
+	}
 
-	for {
-		items, hasNext, err := fetch(ctx, page, perPage)
+	// Pages needed to reach limit, assuming full pages.
+	maxPages := 0
+	if limit > 0 {
+		maxPages = (limit + perPage - 1) / perPage
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if meta.LastPage > 1 {
+		last := meta.LastPage
+		if maxPages > 0 && maxPages < last {
+			last = maxPages
+		}
+		results, err := fetchPages(ctx, cancel, fetch, 2, last, perPage)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		for _, r := range results {
+			allItems = append(allItems, r.items...)
+		}
+		if limit > 0 && len(allItems) > limit {
+			allItems = allItems[:limit]
 		}
+		return allItems, meta, nil
+	}
 
-		allItems = append(allItems, items...)
+	for page := 2; ; {
+		n := concurrency
+		if maxPages > 0 && page+n-1 > maxPages {
+			n = maxPages - page + 1
+		}
+		if n < 1 {
+			// Short pages left us below limit; keep going one at a time.
+			n = 1
+		}
 
-		if limit > 0 && len(allItems) >= limit {
-			allItems = allItems[:limit]
-			break
+		results, err := fetchPages(ctx, cancel, fetch, page, page+n-1, perPage)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, r := range results {
+			allItems = append(allItems, r.items...)
+			if limit > 0 && len(allItems) >= limit {
+				return allItems[:limit], meta, nil
+			}
+			if !r.hasNext {
+				return allItems, meta, nil
+			}
 		}
+		page += n
+	}
+}
+
+// fetchPages requests pages first through last, at most concurrency at a
+// time, and returns their results in page order. The first error cancels
+// the remaining requests and is returned in preference to the cancellations
+// it caused.
+func fetchPages[T any](ctx context.Context, cancel context.CancelFunc, fetch PageFetcher[T], first, last, perPage int) ([]pageResult[T], error) {
+	results := make([]pageResult[T], last-first+1)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var firstErr error
+	var errOnce sync.Once
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if err := ctx.Err(); err != nil {
+				results[i] = pageResult[T]{err: err}
+				return
+			}
+			items, hasNext, err := fetch(ctx, first+i, perPage)
+			results[i] = pageResult[T]{items: items, hasNext: hasNext, err: err}
+			if err != nil && !errors.Is(err, context.Canceled) {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
 
-		if !hasNext {
-			break
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
 		}
-		page++
 	}
+	return results, nil
+}
+
+// PageMeta is the pagination metadata of a single list response. LastPage
+// and Total are 0 when the endpoint does not report them.
+type PageMeta struct {
+	CurrentPage int
+	LastPage    int
+	PerPage     int
+	Total       int
+}
+
+// Fields returns the metadata for the meta object of --envelope output:
+// page and per_page, and last_page and total when the endpoint reports them.
+func (m PageMeta) Fields() map[string]interface{} {
+	fields := map[string]interface{}{"page": m.CurrentPage, "per_page": m.PerPage}
+	if m.LastPage > 0 {
+		fields["last_page"] = m.LastPage
+	}
+	if m.Total > 0 {
+		fields["total"] = m.Total
+	}
+	return fields
+}
+
+// Footer describes the page for display after a table of shown items,
+// e.g. "Page 2 of 17 (showing 25 of 412)".
+func (m PageMeta) Footer(shown int) string {
+	if m.LastPage > 0 && m.Total > 0 {
+		return fmt.Sprintf("Page %d of %d (showing %d of %d)", m.CurrentPage, m.LastPage, shown, m.Total)
+	}
+	return fmt.Sprintf("Page %d (showing %d)", m.CurrentPage, shown)
+}
+
+// pageMetaKey is the context key under which FetchPage asks CLITransport to
+// record the response's pagination metadata.
+type pageMetaKey struct{}
+
+// FetchPage fetches a single page and returns its items with the
+// response's pagination metadata. The SDK's list types drop last_page and
+// total, so CLITransport reads them from the response body for requests
+// made with the context passed to fetch.
+func FetchPage[T any](ctx context.Context, fetch PageFetcher[T], page, perPage int) ([]T, *PageMeta, error) {
+	meta := &PageMeta{CurrentPage: page, PerPage: perPage}
+	items, _, err := fetch(context.WithValue(ctx, pageMetaKey{}, meta), page, perPage)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, meta, nil
+}
 
-	return allItems, nil
+// recordPageMeta parses the "meta" object of a list response body into the
+// PageMeta registered on ctx by FetchPage, if any.
+func recordPageMeta(ctx context.Context, body []byte) {
+	meta, ok := ctx.Value(pageMetaKey{}).(*PageMeta)
+	if !ok {
+		return
+	}
+	var parsed struct {
+		Meta map[string]interface{} `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil || parsed.Meta == nil {
+		return
+	}
+	for key, field := range map[string]*int{
+		"current_page": &meta.CurrentPage,
+		"last_page":    &meta.LastPage,
+		"per_page":     &meta.PerPage,
+		"total":        &meta.Total,
+	} {
+		// The API reports some of these as strings.
+		switch v := parsed.Meta[key].(type) {
+		case float64:
+			*field = int(v)
+		case string:
+			if n, err := strconv.Atoi(v); err == nil {
+				*field = n
+			}
+		}
+	}
 }
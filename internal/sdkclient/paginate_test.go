@@ -0,0 +1,128 @@
+package sdkclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// pagedSource serves total items in pages and records which pages were
+// requested.
+type pagedSource struct {
+	total  int
+	failOn int
+	// withMeta makes the source report meta.last_page, as most list
+	// endpoints do.
+	withMeta bool
+
+	mu        sync.Mutex
+	requested map[int]bool
+}
+
+func (s *pagedSource) fetch(ctx context.Context, page, perPage int) ([]int, bool, error) {
+	s.mu.Lock()
+	s.requested[page] = true
+	s.mu.Unlock()
+	if page == s.failOn {
+		return nil, false, errors.New("page failed")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+	if meta, ok := ctx.Value(pageMetaKey{}).(*PageMeta); ok && s.withMeta {
+		meta.LastPage = (s.total + perPage - 1) / perPage
+	}
+	var items []int
+	for i := (page - 1) * perPage; i < page*perPage && i < s.total; i++ {
+		items = append(items, i)
+	}
+	return items, page*perPage < s.total, nil
+}
+
+func newPagedSource(total int) *pagedSource {
+	return &pagedSource{total: total, requested: map[int]bool{}}
+}
+
+func TestFetchAll_ConcurrentPagesInOrder(t *testing.T) {
+	for _, sequential := range []bool{false, true} {
+		SetSequential(sequential)
+		src := newPagedSource(230)
+		items, err := FetchAll(context.Background(), src.fetch, 0)
+		if err != nil {
+			t.Fatalf("sequential=%v: %v", sequential, err)
+		}
+		if len(items) != 230 {
+			t.Fatalf("sequential=%v: got %d items, want 230", sequential, len(items))
+		}
+		for i, v := range items {
+			if v != i {
+				t.Fatalf("sequential=%v: item %d = %d, out of order", sequential, i, v)
+			}
+		}
+	}
+	SetSequential(false)
+}
+
+func TestFetchAll_LimitTruncates(t *testing.T) {
+	src := newPagedSource(500)
+	items, err := FetchAll(context.Background(), src.fetch, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 60 || items[59] != 59 {
+		t.Fatalf("got %d items, want exactly 60 in order", len(items))
+	}
+	// 60 items at 25 per page need three pages; none beyond.
+	for page := range src.requested {
+		if page > 3 {
+			t.Errorf("requested page %d beyond the limit", page)
+		}
+	}
+}
+
+func TestFetchAll_ErrorStopsFetching(t *testing.T) {
+	src := newPagedSource(1000)
+	src.failOn = 3
+	_, err := FetchAll(context.Background(), src.fetch, 0)
+	if err == nil || err.Error() != "page failed" {
+		t.Fatalf("expected the page error, got %v", err)
+	}
+	// The failing batch is pages 2-5; nothing after it is requested.
+	for page := range src.requested {
+		if page > 5 {
+			t.Errorf("requested page %d after an error", page)
+		}
+	}
+}
+
+func TestFetchAll_LastPageBoundsRequests(t *testing.T) {
+	for _, sequential := range []bool{false, true} {
+		SetSequential(sequential)
+		src := newPagedSource(230)
+		src.withMeta = true
+		items, err := FetchAll(context.Background(), src.fetch, 0)
+		if err != nil {
+			t.Fatalf("sequential=%v: %v", sequential, err)
+		}
+		if len(items) != 230 || items[229] != 229 {
+			t.Fatalf("sequential=%v: got %d items, want 230 in order", sequential, len(items))
+		}
+		// 230 items at 25 per page end on page 10; nothing past it is
+		// requested speculatively.
+		if len(src.requested) != 10 {
+			t.Errorf("sequential=%v: requested %d pages, want 10", sequential, len(src.requested))
+		}
+	}
+	SetSequential(false)
+
+	src := newPagedSource(500)
+	src.withMeta = true
+	items, err := FetchAll(context.Background(), src.fetch, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 60 || len(src.requested) != 3 {
+		t.Errorf("got %d items from %d pages, want 60 from 3", len(items), len(src.requested))
+	}
+}
@@ -0,0 +1,77 @@
+package sdkclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/mailersend/mailersend-go"
+)
+
+// GetJSON issues a raw GET for path (e.g. "/messages/abc") and decodes the
+// response into v. It is for fields the SDK's types drop; the request goes
+// through the SDK's HTTP client, so the CLI transport still applies.
+func GetJSON(ctx context.Context, ms *mailersend.Mailersend, path string, v interface{}) error {
+	return doJSON(ctx, ms, http.MethodGet, path, nil, v)
+}
+
+// PostJSON issues a raw POST of body, encoded as JSON, to path and decodes
+// the response into v. Like GetJSON, it is for payload fields the SDK's
+// option types do not carry.
+func PostJSON(ctx context.Context, ms *mailersend.Mailersend, path string, body, v interface{}) error {
+	return doJSON(ctx, ms, http.MethodPost, path, body, v)
+}
+
+// PutJSON issues a raw PUT of body, encoded as JSON, to path and decodes the
+// response into v.
+func PutJSON(ctx context.Context, ms *mailersend.Mailersend, path string, body, v interface{}) error {
+	return doJSON(ctx, ms, http.MethodPut, path, body, v)
+}
+
+func doJSON(ctx context.Context, ms *mailersend.Mailersend, method, path string, body, v interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, "https://api.mailersend.com/v1"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+ms.APIKey())
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := ms.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 400 {
+		return ResponseError(resp.StatusCode, respBody)
+	}
+
+	// 2xx responses such as 204 No Content have no body to decode.
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, v); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
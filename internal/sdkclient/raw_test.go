@@ -0,0 +1,47 @@
+package sdkclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-go"
+)
+
+func TestPostJSON_ValidationErrorIsCLIError(t *testing.T) {
+	const body = `{"message":"The given data was invalid.","errors":{"url":["The url must be a valid URL."],"events":["The events field is required."]}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(body)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	ms := mailersend.NewMailersend("test-token")
+	ms.SetClient(&http.Client{Transport: &CLITransport{Base: http.DefaultTransport, BaseURL: server.URL}})
+
+	err := PostJSON(context.Background(), ms, "/webhooks", map[string]string{"name": "x"}, &struct{}{})
+	var cliErr *CLIError
+	if !errors.As(err, &cliErr) {
+		t.Fatalf("err = %T %v, want *CLIError", err, err)
+	}
+	if cliErr.StatusCode != http.StatusUnprocessableEntity || string(cliErr.RawBody) != body {
+		t.Errorf("CLIError = %+v", cliErr)
+	}
+	want := "API error 422: The given data was invalid.\n" +
+		"\n  events  The events field is required." +
+		"\n  url     The url must be a valid URL."
+	if got := err.Error(); got != want {
+		t.Errorf("Error() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestResponseError_NonJSONBody(t *testing.T) {
+	err := ResponseError(http.StatusBadGateway, []byte("<html>bad gateway</html>\n"))
+	if err.RawBody != nil || !strings.Contains(err.Error(), "502: <html>bad gateway</html>") {
+		t.Errorf("ResponseError = %+v, %q", err, err.Error())
+	}
+}
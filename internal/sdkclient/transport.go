@@ -3,13 +3,17 @@ package sdkclient
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/version"
 )
 
 const (
@@ -17,13 +21,6 @@ const (
 	maxRetries     = 3
 )
 
-var userAgent = "mailersend-cli/dev"
-
-// SetUserAgent sets the User-Agent string used for all API requests.
-func SetUserAgent(ua string) {
-	userAgent = ua
-}
-
 // CLITransport wraps an http.RoundTripper with CLI-specific behavior:
 // retry logic, verbose logging, user-agent override, base URL rewrite,
 // and error body capture for the error bridge.
@@ -33,6 +30,28 @@ type CLITransport struct {
 	BaseURL string // if set, replaces the SDK's hardcoded base URL
 }
 
+// ProxyTransport returns a base transport for CLITransport that sends
+// requests through proxyURL, or, when proxyURL is empty, through the proxy
+// named by HTTP_PROXY, HTTPS_PROXY, and NO_PROXY.
+func ProxyTransport(proxyURL string) (http.RoundTripper, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = http.ProxyFromEnvironment
+	if proxyURL == "" {
+		return t, nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: use a URL like http://proxy.example.com:8080", proxyURL)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https, or socks5", proxyURL)
+	}
+	t.Proxy = http.ProxyURL(u)
+	return t, nil
+}
+
 func (t *CLITransport) base() http.RoundTripper {
 	if t.Base != nil {
 		return t.Base
@@ -54,7 +73,7 @@ func (t *CLITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 
 	// Override User-Agent.
-	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("User-Agent", version.UserAgent())
 
 	// Capture request body for retries.
 	var bodyBytes []byte
@@ -69,8 +88,11 @@ func (t *CLITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	if t.Verbose {
 		fmt.Printf("--> %s %s\n", req.Method, req.URL)
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			fmt.Printf("--> Authorization: %s\n", redactAuthorization(auth))
+		}
 		if len(bodyBytes) > 0 {
-			fmt.Printf("--> body: %s\n", string(bodyBytes))
+			fmt.Printf("--> body: %s\n", redactBody(bodyBytes))
 		}
 	}
 
@@ -90,7 +112,8 @@ func (t *CLITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			if t.Verbose {
 				fmt.Printf("<-- error: %v\n", lastErr)
 			}
-			if attempt == maxRetries {
+			// A canceled or timed-out request will not succeed on retry.
+			if attempt == maxRetries || req.Context().Err() != nil {
 				break
 			}
 			backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
@@ -108,7 +131,7 @@ func (t *CLITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			resp.Body.Close() //nolint:errcheck
 
 			if t.Verbose && len(respBody) > 0 {
-				fmt.Printf("<-- body: %s\n", string(respBody))
+				fmt.Printf("<-- body: %s\n", redactBody(respBody))
 			}
 
 			// Store for WrapError.
@@ -139,13 +162,15 @@ func (t *CLITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			return resp, nil
 		}
 
-		// Success — capture body for verbose logging, then re-wrap.
-		if t.Verbose {
+		// Success — capture body for verbose logging and page metadata,
+		// then re-wrap.
+		if t.Verbose || req.Context().Value(pageMetaKey{}) != nil {
 			respBody, _ := io.ReadAll(resp.Body)
 			resp.Body.Close() //nolint:errcheck
-			if len(respBody) > 0 {
-				fmt.Printf("<-- body: %s\n", string(respBody))
+			if t.Verbose && len(respBody) > 0 {
+				fmt.Printf("<-- body: %s\n", redactBody(respBody))
 			}
+			recordPageMeta(req.Context(), respBody)
 			resp.Body = io.NopCloser(bytes.NewReader(respBody))
 		}
 
@@ -157,3 +182,66 @@ func (t *CLITransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 	return resp, nil
 }
+
+// sensitiveFields are the JSON keys whose values verbose logging replaces
+// with "***": credentials in request bodies, and tokens, secrets, and
+// passwords that the API returns.
+var sensitiveFields = map[string]bool{
+	"api_token":    true,
+	"access_token": true,
+	"accessToken":  true,
+	"secret":       true,
+	"password":     true,
+}
+
+// redactAuthorization keeps the scheme of an Authorization header and hides
+// the credentials, so "Bearer mlsn.abc" logs as "Bearer ***".
+func redactAuthorization(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " ***"
+	}
+	return "***"
+}
+
+// redactBody returns a JSON body for verbose logging with sensitiveFields
+// masked at any depth. Bodies that are not JSON are returned unchanged.
+func redactBody(body []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return string(body)
+	}
+	if !redactValue(v) {
+		return string(body)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+// redactValue masks sensitiveFields in v in place and reports whether it
+// changed anything.
+func redactValue(v interface{}) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if sensitiveFields[k] {
+				if s, ok := field.(string); ok && s != "" {
+					v[k] = "***"
+					changed = true
+				}
+				continue
+			}
+			changed = redactValue(field) || changed
+		}
+	case []interface{}:
+		for _, item := range v {
+			changed = redactValue(item) || changed
+		}
+	}
+	return changed
+}
@@ -0,0 +1,74 @@
+package sdkclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mailersend/mailersend-cli/internal/version"
+)
+
+func TestCLITransport_VerboseRedactsCredentials(t *testing.T) {
+	const secret = "mlsn.super-secret-token"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"tok-1","accessToken":"` + secret + `","items":[{"secret":"` + secret + `"}]}}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	origStdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+
+	client := &http.Client{Transport: &CLITransport{Verbose: true}}
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/token", strings.NewReader(`{"name":"ci","api_token":"`+secret+`"}`))
+	req.Header.Set("Authorization", "Bearer "+secret)
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close() //nolint:errcheck
+	}
+
+	w.Close() //nolint:errcheck
+	os.Stdout = origStdout
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	out, _ := io.ReadAll(r)
+
+	if strings.Contains(string(out), secret) {
+		t.Errorf("verbose output leaked the token:\n%s", out)
+	}
+	for _, want := range []string{"--> Authorization: Bearer ***", `"api_token":"***"`, `"accessToken":"***"`, `"name":"ci"`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("verbose output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestCLITransport_UserAgentFollowsVersion(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	orig := version.Version
+	version.Version = "1.2.3"
+	defer func() { version.Version = orig }()
+
+	client := &http.Client{Transport: &CLITransport{}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close() //nolint:errcheck
+	if got != "mailersend-cli/1.2.3" {
+		t.Errorf("User-Agent = %q, want mailersend-cli/1.2.3", got)
+	}
+}
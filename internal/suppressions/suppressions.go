@@ -0,0 +1,210 @@
+// Package suppressions holds the suppression list logic shared by the
+// commands that add to or look up MailerSend's suppression lists.
+package suppressions
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/mailersend/mailersend-cli/internal/sdkclient"
+	"github.com/mailersend/mailersend-go"
+)
+
+// MaxAddBatch caps how many entries go into one add request; larger imports
+// are split across several requests.
+const MaxAddBatch = 500
+
+// AddInBatches calls add with successive batches of at most MaxAddBatch
+// entries and collects the created entries. When a later batch fails, the
+// error reports how many entries the earlier ones created.
+func AddInBatches[E, T any](entries []E, add func(batch []E) ([]T, error)) ([]T, error) {
+	var created []T
+	for start := 0; start < len(entries); start += MaxAddBatch {
+		end := min(start+MaxAddBatch, len(entries))
+		data, err := add(entries[start:end])
+		if err != nil {
+			if len(entries) > MaxAddBatch {
+				err = fmt.Errorf("added %d of %d before failure: %w", len(created), len(entries), err)
+			}
+			return created, err
+		}
+		created = append(created, data...)
+	}
+	return created, nil
+}
+
+// Item is one entry of any suppression list, in a common shape for display
+// and export.
+type Item struct {
+	ID           string
+	Type         string
+	PatternEmail string
+	CreatedAt    string
+}
+
+// Types are the suppression lists, in display order.
+var Types = []string{mailersend.BlockList, mailersend.HardBounces, mailersend.SpamComplaints, mailersend.Unsubscribes, "on-hold"}
+
+// Fetch fetches every entry of one suppression list, optionally filtered by
+// domain ID.
+func Fetch(ctx context.Context, ms *mailersend.Mailersend, listType, domainID string) ([]Item, error) {
+	switch listType {
+	case mailersend.BlockList:
+		return sdkclient.FetchAll(ctx, BlocklistPages(ms, domainID), 0)
+	case mailersend.HardBounces:
+		return sdkclient.FetchAll(ctx, HardBouncesPages(ms, domainID), 0)
+	case mailersend.SpamComplaints:
+		return sdkclient.FetchAll(ctx, SpamComplaintsPages(ms, domainID), 0)
+	case mailersend.Unsubscribes:
+		return sdkclient.FetchAll(ctx, UnsubscribesPages(ms, domainID), 0)
+	case "on-hold":
+		entries, err := sdkclient.ListOnHold(ctx, ms, domainID, 0)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]Item, 0, len(entries))
+		for _, e := range entries {
+			value := e.Pattern
+			if value == "" {
+				value = e.Recipient.Email
+			}
+			items = append(items, Item{ID: e.ID, Type: e.Type, PatternEmail: value, CreatedAt: e.CreatedAt})
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("invalid suppression type %q: use %s", listType, strings.Join(Types, ", "))
+}
+
+// BlocklistPages fetches pages of the blocklist as Items.
+func BlocklistPages(ms *mailersend.Mailersend, domainID string) sdkclient.PageFetcher[Item] {
+	return func(ctx context.Context, page, perPage int) ([]Item, bool, error) {
+		root, _, err := ms.Suppression.ListBlockList(ctx, &mailersend.SuppressionOptions{
+			DomainID: domainID,
+			Page:     page,
+			Limit:    perPage,
+		})
+		if err != nil {
+			return nil, false, sdkclient.WrapError(err)
+		}
+		var out []Item
+		for _, d := range root.Data {
+			out = append(out, Item{
+				ID:           d.ID,
+				Type:         d.Type,
+				PatternEmail: d.Pattern,
+				CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return out, root.Next != "", nil
+	}
+}
+
+// HardBouncesPages fetches pages of the hard bounces list as Items.
+func HardBouncesPages(ms *mailersend.Mailersend, domainID string) sdkclient.PageFetcher[Item] {
+	return func(ctx context.Context, page, perPage int) ([]Item, bool, error) {
+		root, _, err := ms.Suppression.ListHardBounces(ctx, &mailersend.SuppressionOptions{
+			DomainID: domainID,
+			Page:     page,
+			Limit:    perPage,
+		})
+		if err != nil {
+			return nil, false, sdkclient.WrapError(err)
+		}
+		var out []Item
+		for _, d := range root.Data {
+			out = append(out, Item{
+				ID:           d.ID,
+				PatternEmail: d.Recipient.Email,
+				CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return out, root.Next != "", nil
+	}
+}
+
+// SpamComplaintsPages fetches pages of the spam complaints list as Items.
+func SpamComplaintsPages(ms *mailersend.Mailersend, domainID string) sdkclient.PageFetcher[Item] {
+	return func(ctx context.Context, page, perPage int) ([]Item, bool, error) {
+		root, _, err := ms.Suppression.ListSpamComplaints(ctx, &mailersend.SuppressionOptions{
+			DomainID: domainID,
+			Page:     page,
+			Limit:    perPage,
+		})
+		if err != nil {
+			return nil, false, sdkclient.WrapError(err)
+		}
+		var out []Item
+		for _, d := range root.Data {
+			out = append(out, Item{
+				ID:           d.ID,
+				PatternEmail: d.Recipient.Email,
+				CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return out, root.Next != "", nil
+	}
+}
+
+// UnsubscribesPages fetches pages of the unsubscribes list as Items.
+func UnsubscribesPages(ms *mailersend.Mailersend, domainID string) sdkclient.PageFetcher[Item] {
+	return func(ctx context.Context, page, perPage int) ([]Item, bool, error) {
+		root, _, err := ms.Suppression.ListUnsubscribes(ctx, &mailersend.SuppressionOptions{
+			DomainID: domainID,
+			Page:     page,
+			Limit:    perPage,
+		})
+		if err != nil {
+			return nil, false, sdkclient.WrapError(err)
+		}
+		var out []Item
+		for _, d := range root.Data {
+			out = append(out, Item{
+				ID:           d.ID,
+				PatternEmail: d.Recipient.Email,
+				CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
+			})
+		}
+		return out, root.Next != "", nil
+	}
+}
+
+// Match is a suppression entry that applies to a checked address.
+type Match struct {
+	List         string `json:"list"`
+	ID           string `json:"id"`
+	PatternEmail string `json:"pattern_email"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// Check returns the entries on every suppression list that
+// apply to email, in Types order.
+func Check(ctx context.Context, ms *mailersend.Mailersend, email, domainID string) ([]Match, error) {
+	email = strings.ToLower(email)
+	matches := []Match{}
+	for _, t := range Types {
+		items, err := Fetch(ctx, ms, t, domainID)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range items {
+			if !applies(i, email) {
+				continue
+			}
+			matches = append(matches, Match{List: t, ID: i.ID, PatternEmail: i.PatternEmail, CreatedAt: i.CreatedAt})
+		}
+	}
+	return matches, nil
+}
+
+// applies reports whether entry suppresses the lowercase email,
+// either as the same address or as a matching wildcard pattern.
+func applies(entry Item, email string) bool {
+	value := strings.ToLower(entry.PatternEmail)
+	if value == email {
+		return true
+	}
+	ok, _ := path.Match(value, email)
+	return ok
+}
@@ -16,21 +16,30 @@ import (
 )
 
 var (
-	headerStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Primary).
-			Padding(0, 1)
+	headerStyle    lipgloss.Style
+	headerBarStyle lipgloss.Style
+	contentStyle   lipgloss.Style
+	errorStyle     lipgloss.Style
+)
 
-	headerBarStyle = lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder(), false, false, true, false).
-			BorderForeground(theme.Muted)
+func init() {
+	theme.OnApply(appStyles)
+}
 
+// appStyles builds the app styles from the current theme colors.
+func appStyles() {
+	headerStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Primary).
+		Padding(0, 1)
+	headerBarStyle = lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		BorderForeground(theme.Muted)
 	contentStyle = lipgloss.NewStyle().
-			Padding(0, 1)
-
+		Padding(0, 1)
 	errorStyle = lipgloss.NewStyle().
-			Foreground(theme.Error)
-)
+		Foreground(theme.Error)
+}
 
 // FocusArea represents which area of the UI is focused.
 type FocusArea int
@@ -141,6 +150,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, a.keys.Tab):
 			a.toggleFocus()
 			return a, nil
+		case key.Matches(msg, a.keys.Export):
+			a.exportView("csv")
+			return a, nil
+		case key.Matches(msg, a.keys.ExportJSON):
+			a.exportView("json")
+			return a, nil
 		case key.Matches(msg, a.keys.View1):
 			return a, a.switchView(types.ViewDomains)
 		case key.Matches(msg, a.keys.View2):
@@ -258,6 +273,7 @@ func (a *App) switchView(v types.ViewType) tea.Cmd {
 	a.setCurrentViewFocused(false)
 	a.activeView = v
 	a.sidebar.SetActive(v)
+	a.statusbar.SetCenter("")
 	a.setCurrentViewFocused(a.focus == FocusContent)
 	a.updateStatusBar()
 
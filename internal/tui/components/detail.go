@@ -8,23 +8,32 @@ import (
 )
 
 var (
-	detailTitleStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(theme.Primary).
-				MarginBottom(1)
+	detailTitleStyle lipgloss.Style
+	detailLabelStyle lipgloss.Style
+	detailValueStyle lipgloss.Style
+	detailHintStyle  lipgloss.Style
+)
 
-	detailLabelStyle = lipgloss.NewStyle().
-				Foreground(theme.Muted).
-				Width(20)
+func init() {
+	theme.OnApply(detailStyles)
+}
 
+// detailStyles builds the detail styles from the current theme colors.
+func detailStyles() {
+	detailTitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Primary).
+		MarginBottom(1)
+	detailLabelStyle = lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Width(20)
 	detailValueStyle = lipgloss.NewStyle().
-				Foreground(theme.Text)
-
+		Foreground(theme.Text)
 	detailHintStyle = lipgloss.NewStyle().
-			Foreground(theme.Muted).
-			Italic(true).
-			MarginTop(1)
-)
+		Foreground(theme.Muted).
+		Italic(true).
+		MarginTop(1)
+}
 
 // DetailRow represents a key-value pair for display.
 type DetailRow struct {
@@ -9,28 +9,37 @@ import (
 )
 
 var (
-	helpOverlayStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(theme.Primary).
-				Padding(1, 2).
-				Background(theme.BgOverlay)
+	helpOverlayStyle lipgloss.Style
+	helpTitleStyle   lipgloss.Style
+	helpKeyStyle     lipgloss.Style
+	helpDescStyle    lipgloss.Style
+	helpSectionStyle lipgloss.Style
+)
 
-	helpTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Primary).
-			MarginBottom(1)
+func init() {
+	theme.OnApply(helpStyles)
+}
 
+// helpStyles builds the help styles from the current theme colors.
+func helpStyles() {
+	helpOverlayStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary).
+		Padding(1, 2).
+		Background(theme.BgOverlay)
+	helpTitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Primary).
+		MarginBottom(1)
 	helpKeyStyle = lipgloss.NewStyle().
-			Foreground(theme.Key).
-			Width(14)
-
+		Foreground(theme.Key).
+		Width(14)
 	helpDescStyle = lipgloss.NewStyle().
-			Foreground(theme.TextSub)
-
+		Foreground(theme.TextSub)
 	helpSectionStyle = lipgloss.NewStyle().
-				MarginTop(1).
-				MarginBottom(0)
-)
+		MarginTop(1).
+		MarginBottom(0)
+}
 
 // Help is the help overlay component.
 type Help struct {
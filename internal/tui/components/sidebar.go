@@ -11,27 +11,36 @@ import (
 const SidebarWidth = 20
 
 var (
-	sidebarStyle = lipgloss.NewStyle().
-			Width(SidebarWidth).
-			Border(lipgloss.NormalBorder(), false, true, false, false).
-			BorderForeground(theme.Muted).
-			Padding(1, 1)
+	sidebarStyle     lipgloss.Style
+	itemStyle        lipgloss.Style
+	activeItemStyle  lipgloss.Style
+	focusedItemStyle lipgloss.Style
+)
 
-	itemStyle = lipgloss.NewStyle().
-			Padding(0, 1)
+func init() {
+	theme.OnApply(sidebarStyles)
+}
 
+// sidebarStyles builds the sidebar styles from the current theme colors.
+func sidebarStyles() {
+	sidebarStyle = lipgloss.NewStyle().
+		Width(SidebarWidth).
+		Border(lipgloss.NormalBorder(), false, true, false, false).
+		BorderForeground(theme.Muted).
+		Padding(1, 1)
+	itemStyle = lipgloss.NewStyle().
+		Padding(0, 1)
 	activeItemStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Primary).
-			Background(theme.BgSelected).
-			Padding(0, 1)
-
+		Bold(true).
+		Foreground(theme.Primary).
+		Background(theme.BgSelected).
+		Padding(0, 1)
 	focusedItemStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(theme.Text).
-				Background(theme.Accent).
-				Padding(0, 1)
-)
+		Bold(true).
+		Foreground(theme.Text).
+		Background(theme.Accent).
+		Padding(0, 1)
+}
 
 // Sidebar is the navigation sidebar component.
 type Sidebar struct {
@@ -54,6 +54,11 @@ func (s *StatusBar) SetCenter(text string) {
 	s.center = text
 }
 
+// Center returns the center section text.
+func (s StatusBar) Center() string {
+	return s.center
+}
+
 // SetRight sets the right section text.
 func (s *StatusBar) SetRight(text string) {
 	s.right = text
@@ -8,26 +8,35 @@ import (
 )
 
 var (
-	tableHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(theme.Primary).
-				Border(lipgloss.NormalBorder(), false, false, true, false).
-				BorderForeground(theme.Muted)
+	tableHeaderStyle          lipgloss.Style
+	tableRowStyle             lipgloss.Style
+	tableSelectedStyle        lipgloss.Style
+	tableFocusedSelectedStyle lipgloss.Style
+	emptyStyle                lipgloss.Style
+)
 
-	tableRowStyle = lipgloss.NewStyle()
+func init() {
+	theme.OnApply(tableStyles)
+}
 
+// tableStyles builds the table styles from the current theme colors.
+func tableStyles() {
+	tableHeaderStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Primary).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		BorderForeground(theme.Muted)
+	tableRowStyle = lipgloss.NewStyle()
 	tableSelectedStyle = lipgloss.NewStyle().
-				Background(theme.BgSelected).
-				Foreground(theme.Text)
-
+		Background(theme.BgSelected).
+		Foreground(theme.Text)
 	tableFocusedSelectedStyle = lipgloss.NewStyle().
-					Background(theme.Accent).
-					Foreground(theme.Text)
-
+		Background(theme.Accent).
+		Foreground(theme.Text)
 	emptyStyle = lipgloss.NewStyle().
-			Foreground(theme.Muted).
-			Italic(true)
-)
+		Foreground(theme.Muted).
+		Italic(true)
+}
 
 // Column defines a table column.
 type Column struct {
@@ -102,6 +111,16 @@ func (t Table) SelectedRow() []string {
 	return nil
 }
 
+// Headers returns the column titles.
+func (t Table) Headers() []string {
+	return t.columnTitles()
+}
+
+// Rows returns all rows, including those scrolled out of view.
+func (t Table) Rows() [][]string {
+	return t.rows
+}
+
 // RowCount returns the number of rows.
 func (t Table) RowCount() int {
 	return len(t.rows)
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mailersend/mailersend-cli/internal/config"
+	"github.com/mailersend/mailersend-cli/internal/output"
+	"github.com/mailersend/mailersend-cli/internal/tui/types"
+)
+
+// exportData returns the headers and rows of the active view.
+func (a *App) exportData() ([]string, [][]string) {
+	switch a.activeView {
+	case types.ViewDomains:
+		return a.domains.ExportData()
+	case types.ViewActivity:
+		return a.activity.ExportData()
+	case types.ViewAnalytics:
+		return a.analytics.ExportData()
+	case types.ViewMessages:
+		return a.messages.ExportData()
+	case types.ViewSuppressions:
+		return a.suppressions.ExportData()
+	}
+	return nil, nil
+}
+
+// exportView writes the active view's rows to a timestamped file in the
+// exports directory under the config dir and reports the path in the
+// status bar. format is "csv" or "json".
+func (a *App) exportView(format string) {
+	headers, rows := a.exportData()
+	path, err := writeExport(a.activeView.String(), format, headers, rows)
+	if err != nil {
+		a.statusbar.SetCenter("Export failed: " + err.Error())
+		return
+	}
+	a.statusbar.SetCenter(fmt.Sprintf("Exported %d rows to %s", len(rows), path))
+}
+
+// writeExport creates <config dir>/exports/<view>-<timestamp>.<format> and
+// writes the rows to it with the output package's CSV or JSON writer.
+func writeExport(view, format string, headers []string, rows [][]string) (string, error) {
+	var write func(io.Writer, []string, [][]string) error
+	switch format {
+	case "csv":
+		write = output.WriteCSV
+	case "json":
+		write = output.WriteRecords
+	default:
+		return "", fmt.Errorf("unsupported export format %q", format)
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, "exports")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	name := fmt.Sprintf("%s-%s.%s", strings.ToLower(view), time.Now().Format("20060102-150405"), format)
+	path := filepath.Join(dir, name)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	if err := write(f, headers, rows); err != nil {
+		f.Close() //nolint:errcheck
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
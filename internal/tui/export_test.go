@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mailersend/mailersend-cli/internal/tui/types"
+)
+
+func TestExport_MessagesViewWritesRows(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	app := NewApp(nil, "default")
+	app.switchView(types.ViewMessages)
+	app.Update(types.MessagesLoadedMsg{Messages: []types.MessageItem{
+		{ID: "msg-2", CreatedAt: "2024-01-02T10:00:00Z", UpdatedAt: "2024-01-02T10:05:00Z"},
+		{ID: "msg-1", CreatedAt: "2024-01-01T09:00:00Z", UpdatedAt: "2024-01-01T09:30:00Z"},
+	}})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+
+	files, err := filepath.Glob(filepath.Join(dir, "mailersend", "exports", "messages-*.csv"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected one messages export, got %v (err %v)", files, err)
+	}
+	f, err := os.Open(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close() //nolint:errcheck
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{
+		{"MESSAGE ID", "CREATED", "UPDATED"},
+		{"msg-2", "2024-01-02 10:00:00", "2024-01-02 10:05:00"},
+		{"msg-1", "2024-01-01 09:00:00", "2024-01-01 09:30:00"},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("export rows = %v, want %v", records, want)
+	}
+	if !strings.Contains(app.statusbar.Center(), files[0]) {
+		t.Errorf("status bar = %q, want it to name %s", app.statusbar.Center(), files[0])
+	}
+}
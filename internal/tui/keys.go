@@ -18,6 +18,10 @@ type KeyMap struct {
 	Quit     key.Binding
 	Search   key.Binding
 
+	// Export writes the current view to a CSV file; ExportJSON to JSON.
+	Export     key.Binding
+	ExportJSON key.Binding
+
 	// View shortcuts
 	View1 key.Binding
 	View2 key.Binding
@@ -81,6 +85,14 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("/"),
 			key.WithHelp("/", "search"),
 		),
+		Export: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "export CSV"),
+		),
+		ExportJSON: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "export JSON"),
+		),
 		View1: key.NewBinding(
 			key.WithKeys("1"),
 			key.WithHelp("1", "domains"),
@@ -108,7 +120,7 @@ func DefaultKeyMap() KeyMap {
 func (k KeyMap) HelpBindings() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Enter, k.Back},
-		{k.Tab, k.Refresh, k.Profile, k.Help},
+		{k.Tab, k.Refresh, k.Profile, k.Export, k.ExportJSON, k.Help},
 		{k.View1, k.View2, k.View3, k.View4, k.View5},
 		{k.Quit},
 	}
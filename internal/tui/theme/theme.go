@@ -1,6 +1,9 @@
 package theme
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mailersend/mailersend-cli/internal/config"
+)
 
 var (
 	Primary    = lipgloss.AdaptiveColor{Light: "4", Dark: "12"}
@@ -14,3 +17,33 @@ var (
 	BgSelected = lipgloss.AdaptiveColor{Light: "254", Dark: "238"}
 	BgOverlay  = lipgloss.AdaptiveColor{Light: "255", Dark: "237"}
 )
+
+// builders rebuild package-level styles that depend on the theme colors.
+var builders []func()
+
+// OnApply registers build, which sets package-level styles from the theme
+// colors, and runs it once so the styles start out with the defaults.
+// Apply runs it again whenever the colors change.
+func OnApply(build func()) {
+	builders = append(builders, build)
+	build()
+}
+
+// Apply overrides Primary, Success, and Error with the theme's hex values
+// and rebuilds the styles registered with OnApply. A hex color is used for
+// both light and dark backgrounds. The CLI calls it once the config file,
+// which --config may point elsewhere, has been loaded.
+func Apply(t config.Theme) {
+	if t.Primary != "" {
+		Primary = lipgloss.AdaptiveColor{Light: t.Primary, Dark: t.Primary}
+	}
+	if t.Success != "" {
+		Success = lipgloss.AdaptiveColor{Light: t.Success, Dark: t.Success}
+	}
+	if t.Error != "" {
+		Error = lipgloss.AdaptiveColor{Light: t.Error, Dark: t.Error}
+	}
+	for _, build := range builders {
+		build()
+	}
+}
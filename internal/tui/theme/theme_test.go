@@ -0,0 +1,25 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mailersend/mailersend-cli/internal/config"
+)
+
+func TestApply_RebuildsRegisteredStyles(t *testing.T) {
+	origPrimary, origBuilders := Primary, builders
+	defer func() { Primary, builders = origPrimary, origBuilders }()
+
+	var style lipgloss.Style
+	OnApply(func() { style = lipgloss.NewStyle().Foreground(Primary) })
+	if style.GetForeground() != origPrimary {
+		t.Fatalf("style not built with the default color: %v", style.GetForeground())
+	}
+
+	Apply(config.Theme{Primary: "#ff8800"})
+	want := lipgloss.AdaptiveColor{Light: "#ff8800", Dark: "#ff8800"}
+	if style.GetForeground() != want {
+		t.Errorf("style foreground = %v, want %v", style.GetForeground(), want)
+	}
+}
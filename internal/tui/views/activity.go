@@ -16,20 +16,29 @@ import (
 )
 
 var (
-	domainTabStyle = lipgloss.NewStyle().
-			Padding(0, 2)
+	domainTabStyle       lipgloss.Style
+	activeDomainTabStyle lipgloss.Style
+	domainTabBarStyle    lipgloss.Style
+)
 
-	activeDomainTabStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(theme.Primary).
-				Background(theme.BgSelected).
-				Padding(0, 2)
+func init() {
+	theme.OnApply(activityStyles)
+}
 
+// activityStyles builds the activity styles from the current theme colors.
+func activityStyles() {
+	domainTabStyle = lipgloss.NewStyle().
+		Padding(0, 2)
+	activeDomainTabStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Primary).
+		Background(theme.BgSelected).
+		Padding(0, 2)
 	domainTabBarStyle = lipgloss.NewStyle().
-				Border(lipgloss.NormalBorder(), false, false, true, false).
-				BorderForeground(theme.Muted).
-				MarginBottom(1)
-)
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		BorderForeground(theme.Muted).
+		MarginBottom(1)
+}
 
 // ActivityView displays the activity log.
 type ActivityView struct {
@@ -104,6 +113,11 @@ func (v ActivityView) ItemCount() int {
 	return len(v.items)
 }
 
+// ExportData returns the table's headers and rows for export.
+func (v ActivityView) ExportData() ([]string, [][]string) {
+	return v.table.Headers(), v.table.Rows()
+}
+
 // Fetch returns a command to fetch domains first, then activity.
 func (v ActivityView) Fetch() tea.Cmd {
 	return v.fetchDomains()
@@ -115,7 +129,7 @@ func (v ActivityView) fetchDomains() tea.Cmd {
 			return types.ActivityLoadedMsg{Err: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
 		defer cancel()
 
 		domains, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
@@ -151,7 +165,7 @@ func (v ActivityView) fetchActivity() tea.Cmd {
 
 		domainID := v.domains[v.activeDomainIdx].ID
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
 		defer cancel()
 
 		// Use last 30 days
@@ -16,27 +16,36 @@ import (
 )
 
 var (
-	statBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(theme.Muted).
-			Padding(0, 2).
-			Margin(0, 1)
+	statBoxStyle   lipgloss.Style
+	statLabelStyle lipgloss.Style
+	statValueStyle lipgloss.Style
+	statGoodStyle  lipgloss.Style
+	statBadStyle   lipgloss.Style
+)
 
-	statLabelStyle = lipgloss.NewStyle().
-			Foreground(theme.Muted)
+func init() {
+	theme.OnApply(analyticsStyles)
+}
 
+// analyticsStyles builds the analytics styles from the current theme colors.
+func analyticsStyles() {
+	statBoxStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Muted).
+		Padding(0, 2).
+		Margin(0, 1)
+	statLabelStyle = lipgloss.NewStyle().
+		Foreground(theme.Muted)
 	statValueStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Primary)
-
+		Bold(true).
+		Foreground(theme.Primary)
 	statGoodStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Success)
-
+		Bold(true).
+		Foreground(theme.Success)
 	statBadStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Error)
-)
+		Bold(true).
+		Foreground(theme.Error)
+}
 
 // AnalyticsView displays analytics data.
 type AnalyticsView struct {
@@ -120,6 +129,11 @@ func (v AnalyticsView) daysFromRange() int {
 	}
 }
 
+// ExportData returns the table's headers and rows for export.
+func (v AnalyticsView) ExportData() ([]string, [][]string) {
+	return v.table.Headers(), v.table.Rows()
+}
+
 // Fetch returns a command to fetch analytics.
 func (v AnalyticsView) Fetch() tea.Cmd {
 	return func() tea.Msg {
@@ -127,7 +141,7 @@ func (v AnalyticsView) Fetch() tea.Cmd {
 			return types.AnalyticsLoadedMsg{Err: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
 		defer cancel()
 
 		now := time.Now()
@@ -14,9 +14,19 @@ import (
 )
 
 var (
+	checkStyle lipgloss.Style
+	crossStyle lipgloss.Style
+)
+
+func init() {
+	theme.OnApply(domainStyles)
+}
+
+// domainStyles builds the domain styles from the current theme colors.
+func domainStyles() {
 	checkStyle = lipgloss.NewStyle().Foreground(theme.Success)
 	crossStyle = lipgloss.NewStyle().Foreground(theme.Error)
-)
+}
 
 func check(ok bool) string {
 	if ok {
@@ -103,6 +113,11 @@ func (v DomainsView) SelectedDomain() *mailersend.Domain {
 	return nil
 }
 
+// ExportData returns the table's headers and rows for export.
+func (v DomainsView) ExportData() ([]string, [][]string) {
+	return v.table.Headers(), v.table.Rows()
+}
+
 // Fetch returns a command to fetch domains.
 func (v DomainsView) Fetch() tea.Cmd {
 	return func() tea.Msg {
@@ -110,7 +125,7 @@ func (v DomainsView) Fetch() tea.Cmd {
 			return types.DomainsLoadedMsg{Err: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
 		defer cancel()
 
 		domains, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]mailersend.Domain, bool, error) {
@@ -0,0 +1,14 @@
+package views
+
+import "time"
+
+// fetchTimeout bounds each view's data fetch, across all of its pages.
+var fetchTimeout = 30 * time.Second
+
+// SetFetchTimeout sets how long a view's data fetch may take. The dashboard
+// passes the global --timeout so slow accounts can load large lists.
+func SetFetchTimeout(d time.Duration) {
+	if d > 0 {
+		fetchTimeout = d
+	}
+}
@@ -82,6 +82,11 @@ func (v MessagesView) ItemCount() int {
 	return len(v.items)
 }
 
+// ExportData returns the table's headers and rows for export.
+func (v MessagesView) ExportData() ([]string, [][]string) {
+	return v.table.Headers(), v.table.Rows()
+}
+
 // Fetch returns a command to fetch messages.
 func (v MessagesView) Fetch() tea.Cmd {
 	return func() tea.Msg {
@@ -89,7 +94,7 @@ func (v MessagesView) Fetch() tea.Cmd {
 			return types.MessagesLoadedMsg{Err: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
 		defer cancel()
 
 		items, err := sdkclient.FetchAll(ctx, func(ctx context.Context, page, perPage int) ([]types.MessageItem, bool, error) {
@@ -41,20 +41,29 @@ func (s SuppressionType) String() string {
 }
 
 var (
-	tabStyle = lipgloss.NewStyle().
-			Padding(0, 2)
+	tabStyle       lipgloss.Style
+	activeTabStyle lipgloss.Style
+	tabBarStyle    lipgloss.Style
+)
 
-	activeTabStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(theme.Primary).
-			Background(theme.BgSelected).
-			Padding(0, 2)
+func init() {
+	theme.OnApply(suppressionStyles)
+}
 
+// suppressionStyles builds the suppression styles from the current theme colors.
+func suppressionStyles() {
+	tabStyle = lipgloss.NewStyle().
+		Padding(0, 2)
+	activeTabStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(theme.Primary).
+		Background(theme.BgSelected).
+		Padding(0, 2)
 	tabBarStyle = lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder(), false, false, true, false).
-			BorderForeground(theme.Muted).
-			MarginBottom(1)
-)
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		BorderForeground(theme.Muted).
+		MarginBottom(1)
+}
 
 // SuppressionsView displays suppression lists.
 type SuppressionsView struct {
@@ -127,6 +136,11 @@ func (v SuppressionsView) ItemCount() int {
 	return len(v.items)
 }
 
+// ExportData returns the table's headers and rows for export.
+func (v SuppressionsView) ExportData() ([]string, [][]string) {
+	return v.table.Headers(), v.table.Rows()
+}
+
 // Fetch returns a command to fetch suppressions based on active tab.
 func (v SuppressionsView) Fetch() tea.Cmd {
 	return func() tea.Msg {
@@ -134,7 +148,7 @@ func (v SuppressionsView) Fetch() tea.Cmd {
 			return types.SuppressionsLoadedMsg{Err: nil}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
 		defer cancel()
 
 		var items []types.SuppressionItem
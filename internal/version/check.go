@@ -0,0 +1,94 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// latestReleaseURL is the GitHub API endpoint for the newest release. It is
+// replaced in tests.
+var latestReleaseURL = "https://api.github.com/repos/mailersend/mailersend-cli/releases/latest"
+
+// CheckDisabled reports whether MAILERSEND_NO_UPDATE_CHECK turns update
+// checks off.
+func CheckDisabled() bool {
+	return os.Getenv("MAILERSEND_NO_UPDATE_CHECK") != ""
+}
+
+// Latest returns the version of the newest GitHub release, without a
+// leading "v".
+func Latest(ctx context.Context, client *http.Client) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, latestReleaseURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", UserAgent())
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for updates: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to check for updates: GitHub returned %s", resp.Status)
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return "", fmt.Errorf("failed to check for updates: %w", err)
+	}
+	if release.TagName == "" {
+		return "", fmt.Errorf("failed to check for updates: no release tag")
+	}
+	return strings.TrimPrefix(release.TagName, "v"), nil
+}
+
+// Newer reports whether latest is a higher version than current. Versions
+// are compared as dot-separated numbers; a current version that does not
+// parse, such as "dev", is never considered outdated.
+func Newer(latest, current string) bool {
+	l, ok := parse(latest)
+	if !ok {
+		return false
+	}
+	c, ok := parse(current)
+	if !ok {
+		return false
+	}
+	for i := range l {
+		if l[i] != c[i] {
+			return l[i] > c[i]
+		}
+	}
+	return false
+}
+
+// parse splits a version such as 1.2.3 or v1.2.3-rc.1 into its major,
+// minor, and patch numbers, ignoring any pre-release suffix.
+func parse(v string) ([3]int, bool) {
+	var out [3]int
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return out, false
+	}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return out, false
+		}
+		out[i] = n
+	}
+	return out, true
+}
@@ -0,0 +1,57 @@
+package version
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewer(t *testing.T) {
+	tests := []struct {
+		latest, current string
+		want            bool
+	}{
+		{"1.3.0", "1.2.9", true},
+		{"1.10.0", "1.9.0", true},
+		{"2.0.0", "1.99.99", true},
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3", "1.3.0", false},
+		{"1.2.3", "v1.2.3-rc.1", false},
+		{"1.2.3", "dev", false},
+		{"nightly", "1.2.3", false},
+	}
+	for _, tt := range tests {
+		if got := Newer(tt.latest, tt.current); got != tt.want {
+			t.Errorf("Newer(%q, %q) = %v, want %v", tt.latest, tt.current, got, tt.want)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/releases/latest" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"tag_name":"v1.4.0","name":"v1.4.0"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	orig := latestReleaseURL
+	defer func() { latestReleaseURL = orig }()
+
+	latestReleaseURL = server.URL + "/releases/latest"
+	got, err := Latest(context.Background(), server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "1.4.0" {
+		t.Errorf("Latest = %q, want 1.4.0", got)
+	}
+
+	latestReleaseURL = server.URL + "/missing"
+	if _, err := Latest(context.Background(), server.Client()); err == nil {
+		t.Error("expected an error for a failed request")
+	}
+}
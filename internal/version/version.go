@@ -0,0 +1,19 @@
+// Package version holds the build metadata of the mailersend binary. Release
+// builds set the variables with -ldflags, e.g.
+//
+//	-X github.com/mailersend/mailersend-cli/internal/version.Version=1.2.3
+package version
+
+var (
+	// Version is the release version, without a leading "v".
+	Version = "dev"
+	// Commit is the short git commit the binary was built from.
+	Commit = "none"
+	// Date is when the binary was built.
+	Date = "unknown"
+)
+
+// UserAgent is the User-Agent header sent with API requests.
+func UserAgent() string {
+	return "mailersend-cli/" + Version
+}
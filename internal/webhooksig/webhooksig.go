@@ -0,0 +1,30 @@
+// Package webhooksig computes and checks the signatures MailerSend puts on
+// webhook requests: a hex-encoded HMAC-SHA256 of the raw request body, keyed
+// with the webhook's signing secret and sent in the Signature header.
+package webhooksig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Sign returns the signature MailerSend sends for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of body. The comparison
+// is constant-time and ignores surrounding whitespace and hex case.
+func Verify(secret, signature string, body []byte) bool {
+	got, err := hex.DecodeString(strings.ToLower(strings.TrimSpace(signature)))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,35 @@
+package webhooksig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	// printf '%s' '{"type":"activity.sent"}' | openssl dgst -sha256 -hmac secret
+	const want = "ecbed0658cfb61cfcf17bcd5edcb8cc21b1dc5097c0c8af8c3280f2e14186a37"
+	if got := Sign("secret", []byte(`{"type":"activity.sent"}`)); got != want {
+		t.Errorf("Sign = %q, want %q", got, want)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"type":"activity.sent"}`)
+	sig := Sign("secret", body)
+
+	if !Verify("secret", sig, body) {
+		t.Error("Verify rejected the signature Sign produced")
+	}
+	if !Verify("secret", " "+strings.ToUpper(sig)+"\n", body) {
+		t.Error("Verify should ignore hex case and surrounding whitespace")
+	}
+	if Verify("other", sig, body) {
+		t.Error("Verify accepted a signature made with another secret")
+	}
+	if Verify("secret", sig, append(body, ' ')) {
+		t.Error("Verify accepted a modified body")
+	}
+	if Verify("secret", "not-hex", body) {
+		t.Error("Verify accepted a malformed signature")
+	}
+}